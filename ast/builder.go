@@ -21,6 +21,7 @@ type ASTBuilder struct {
 	nextID                      int64                  // nextID is the next ID to assign to a node.
 	comments                    []*Comment
 	commentsParsed              bool
+	natSpecs                    map[int64]*NatSpec // natSpecs holds the NatSpec documentation keyed by the start of the documented definition.
 	sourceUnits                 []*SourceUnit[Node[ast_pb.SourceUnit]]
 	currentStateVariables       []*StateVariableDeclaration
 	currentUserDefinedVariables []*UserDefinedValueTypeDefinition
@@ -41,6 +42,7 @@ func NewAstBuilder(parser *parser.SolidityParser, sources *solgo.Sources) *ASTBu
 		parser:                      parser,
 		sources:                     sources,
 		comments:                    make([]*Comment, 0),
+		natSpecs:                    make(map[int64]*NatSpec),
 		sourceUnits:                 make([]*SourceUnit[Node[ast_pb.SourceUnit]], 0),
 		currentImports:              make([]Node[NodeType], 0),
 		currentStateVariables:       make([]*StateVariableDeclaration, 0),
@@ -144,4 +146,5 @@ func (b *ASTBuilder) GarbageCollect() {
 	b.currentUserDefinedVariables = nil
 	b.comments = nil
 	b.commentsParsed = false
+	b.natSpecs = nil
 }
@@ -56,7 +56,24 @@ func (b *ASTBuilder) EnterEveryRule(ctx antlr.ParserRuleContext) {
 		stream := b.parser.GetTokenStream().(*antlr.CommonTokenStream)
 		tokens := stream.GetAllTokens()
 
+		// NatSpec comments are attached to the first token following them, which is the start of
+		// the documented definition.
+		docComments := make([]string, 0)
+		if b.natSpecs == nil {
+			b.natSpecs = make(map[int64]*NatSpec)
+		}
+
 		for _, token := range tokens {
+			if token.GetChannel() == antlr.TokenDefaultChannel && len(docComments) > 0 {
+				b.natSpecs[int64(token.GetStart())] = ParseNatSpec(docComments...)
+				docComments = make([]string, 0)
+			}
+
+			if (token.GetTokenType() == parser.SolidityLexerLINE_COMMENT || token.GetTokenType() == parser.SolidityLexerCOMMENT) &&
+				isNatSpecComment(token.GetText()) {
+				docComments = append(docComments, token.GetText())
+			}
+
 			if token.GetTokenType() == parser.SolidityLexerLINE_COMMENT {
 				comment := &Comment{
 					Id: b.GetNextID(),
@@ -46,6 +46,9 @@ type Constructor struct {
 
 	// The body of the constructor, which is a block of statements
 	Body *BodyNode `json:"body"`
+
+	// The NatSpec documentation of the constructor
+	Documentation *NatSpec `json:"documentation,omitempty"`
 }
 
 // NewConstructor creates a new Constructor instance.
@@ -75,6 +78,11 @@ func (c *Constructor) GetSrc() SrcNode {
 	return c.Src
 }
 
+// GetDocumentation returns the NatSpec documentation of the constructor, or nil if it is not documented.
+func (c *Constructor) GetDocumentation() *NatSpec {
+	return c.Documentation
+}
+
 // GetType returns the type of the node, which is 'FUNCTION_DEFINITION' for a constructor.
 func (c *Constructor) GetType() ast_pb.NodeType {
 	return c.NodeType
@@ -176,6 +184,12 @@ func (c *Constructor) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	if documentation, ok := tempMap["documentation"]; ok {
+		if err := json.Unmarshal(documentation, &c.Documentation); err != nil {
+			return err
+		}
+	}
+
 	if implemented, ok := tempMap["implemented"]; ok {
 		if err := json.Unmarshal(implemented, &c.Implemented); err != nil {
 			return err
@@ -274,6 +288,7 @@ func (c *Constructor) Parse(
 		Length:      int64(ctx.GetStop().GetStop() - ctx.GetStart().GetStart() + 1),
 		ParentIndex: contractNode.GetId(),
 	}
+	c.Documentation = c.getDocumentationFromCtx(ctx)
 
 	for _, payableCtx := range ctx.AllPayable() {
 		if payableCtx.GetText() == "payable" {
@@ -13,18 +13,20 @@ import (
 type Contract struct {
 	*ASTBuilder
 
-	Id                      int64            `json:"id"`
-	Name                    string           `json:"name"`
-	NodeType                ast_pb.NodeType  `json:"node_type"`
-	Src                     SrcNode          `json:"src"`
-	NameLocation            SrcNode          `json:"name_location"`
-	Abstract                bool             `json:"abstract"`
-	Kind                    ast_pb.NodeType  `json:"kind"`
-	FullyImplemented        bool             `json:"fully_implemented"`
-	Nodes                   []Node[NodeType] `json:"nodes"`
-	LinearizedBaseContracts []int64          `json:"linearized_base_contracts"`
-	BaseContracts           []*BaseContract  `json:"base_contracts"`
-	ContractDependencies    []int64          `json:"contract_dependencies"`
+	Id                      int64                   `json:"id"`
+	Name                    string                  `json:"name"`
+	NodeType                ast_pb.NodeType         `json:"node_type"`
+	Src                     SrcNode                 `json:"src"`
+	NameLocation            SrcNode                 `json:"name_location"`
+	Abstract                bool                    `json:"abstract"`
+	Kind                    ast_pb.NodeType         `json:"kind"`
+	FullyImplemented        bool                    `json:"fully_implemented"`
+	Nodes                   []Node[NodeType]        `json:"nodes"`
+	LinearizedBaseContracts []int64                 `json:"linearized_base_contracts"`
+	BaseContracts           []*BaseContract         `json:"base_contracts"`
+	ContractDependencies    []int64                 `json:"contract_dependencies"`
+	Documentation           *NatSpec                `json:"documentation,omitempty"`
+	StorageLayout           *StorageLayoutSpecifier `json:"storage_layout,omitempty"`
 }
 
 // NewContractDefinition creates a new instance of Contract.
@@ -61,6 +63,16 @@ func (c *Contract) GetSrc() SrcNode {
 	return c.Src
 }
 
+// GetDocumentation returns the NatSpec documentation of the contract, or nil if it is not documented.
+func (c *Contract) GetDocumentation() *NatSpec {
+	return c.Documentation
+}
+
+// GetStorageLayout returns the storage layout specifier of the Contract, or nil if the default layout is used.
+func (c *Contract) GetStorageLayout() *StorageLayoutSpecifier {
+	return c.StorageLayout
+}
+
 // GetNameLocation returns the source information of the name of the Contract.
 func (c *Contract) GetNameLocation() SrcNode {
 	return c.NameLocation
@@ -257,6 +269,12 @@ func (s *Contract) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	if documentation, ok := tempMap["documentation"]; ok {
+		if err := json.Unmarshal(documentation, &s.Documentation); err != nil {
+			return err
+		}
+	}
+
 	if nameLocation, ok := tempMap["name_location"]; ok {
 		if err := json.Unmarshal(nameLocation, &s.NameLocation); err != nil {
 			return err
@@ -391,8 +409,9 @@ func (c *Contract) Parse(unitCtx *parser.SourceUnitContext, ctx *parser.Contract
 
 	contractId := c.GetNextID()
 	contractNode := &Contract{
-		Id:   contractId,
-		Name: ctx.Identifier().GetText(),
+		Id:            contractId,
+		Name:          ctx.Identifier().GetText(),
+		Documentation: c.getDocumentationFromCtx(ctx),
 		Src: SrcNode{
 			Line:        int64(ctx.GetStart().GetLine()),
 			Column:      int64(ctx.GetStart().GetColumn()),
@@ -409,7 +428,7 @@ func (c *Contract) Parse(unitCtx *parser.SourceUnitContext, ctx *parser.Contract
 			Length:      int64(ctx.Identifier().GetStop().GetStop() - ctx.Identifier().GetStart().GetStart() + 1),
 			ParentIndex: contractId,
 		},
-		Abstract:                false,
+		Abstract:                ctx.Abstract() != nil,
 		NodeType:                ast_pb.NodeType_CONTRACT_DEFINITION,
 		Kind:                    ast_pb.NodeType_KIND_CONTRACT,
 		LinearizedBaseContracts: make([]int64, 0),
@@ -418,6 +437,7 @@ func (c *Contract) Parse(unitCtx *parser.SourceUnitContext, ctx *parser.Contract
 		Nodes:                   make([]Node[NodeType], 0),
 		FullyImplemented:        true,
 	}
+	contractNode.StorageLayout = c.getStorageLayoutFromCtx(ctx, contractId)
 
 	contractNode.BaseContracts = append(
 		contractNode.BaseContracts,
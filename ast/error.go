@@ -10,14 +10,15 @@ import (
 // ErrorDefinition represents an error definition node in the abstract syntax tree.
 type ErrorDefinition struct {
 	*ASTBuilder
-	SourceUnitName  string           `json:"-"`                // Source unit name.
-	Id              int64            `json:"id"`               // Unique identifier of the error definition node.
-	NodeType        ast_pb.NodeType  `json:"node_type"`        // Type of the node.
-	Src             SrcNode          `json:"src"`              // Source location information.
-	Name            string           `json:"name"`             // Name of the error definition.
-	NameLocation    SrcNode          `json:"name_location"`    // Source location information of the name.
-	Parameters      *ParameterList   `json:"parameters"`       // List of error parameters.
-	TypeDescription *TypeDescription `json:"type_description"` // Type description of the error definition.
+	SourceUnitName  string           `json:"-"`                       // Source unit name.
+	Id              int64            `json:"id"`                      // Unique identifier of the error definition node.
+	NodeType        ast_pb.NodeType  `json:"node_type"`               // Type of the node.
+	Src             SrcNode          `json:"src"`                     // Source location information.
+	Name            string           `json:"name"`                    // Name of the error definition.
+	NameLocation    SrcNode          `json:"name_location"`           // Source location information of the name.
+	Parameters      *ParameterList   `json:"parameters"`              // List of error parameters.
+	TypeDescription *TypeDescription `json:"type_description"`        // Type description of the error definition.
+	Documentation   *NatSpec         `json:"documentation,omitempty"` // NatSpec documentation of the error definition.
 }
 
 // NewErrorDefinition creates a new instance of ErrorDefinition with the provided ASTBuilder.
@@ -49,6 +50,11 @@ func (e *ErrorDefinition) GetSrc() SrcNode {
 	return e.Src
 }
 
+// GetDocumentation returns the NatSpec documentation of the error, or nil if it is not documented.
+func (e *ErrorDefinition) GetDocumentation() *NatSpec {
+	return e.Documentation
+}
+
 // GetNameLocation returns the source location information of the name of the error definition.
 func (e *ErrorDefinition) GetNameLocation() SrcNode {
 	return e.NameLocation
@@ -109,6 +115,7 @@ func (e *ErrorDefinition) Parse(
 		Length:      int64(ctx.GetStop().GetStop() - ctx.GetStart().GetStart() + 1),
 		ParentIndex: contractNode.GetId(),
 	}
+	e.Documentation = e.getDocumentationFromCtx(ctx)
 	e.SourceUnitName = unit.GetName()
 	e.Name = ctx.GetName().GetText()
 	e.NameLocation = SrcNode{
@@ -147,6 +154,7 @@ func (e *ErrorDefinition) ParseGlobal(ctx *parser.ErrorDefinitionContext) Node[N
 		Length:      int64(ctx.GetStop().GetStop() - ctx.GetStart().GetStart() + 1),
 		ParentIndex: 0,
 	}
+	e.Documentation = e.getDocumentationFromCtx(ctx)
 	e.SourceUnitName = "Global"
 	e.Name = ctx.GetName().GetText()
 	e.NameLocation = SrcNode{
@@ -182,7 +182,8 @@ func (f *Fallback) Parse(
 	f.StateMutability = f.getStateMutabilityFromCtx(ctx)
 
 	params := NewParameterList(f.ASTBuilder)
-	if len(ctx.AllParameterList()) > 0 {
+	// Return parameters are one of the parameter lists as well, so these cannot be taken as arguments.
+	if len(ctx.AllParameterList()) > 0 && ctx.AllParameterList()[0] != ctx.GetReturnParameters() {
 		params.Parse(unit, f, ctx.AllParameterList()[0])
 	} else {
 		params.Src = f.Src
@@ -39,6 +39,7 @@ type Function struct {
 	ReferencedDeclaration int64                 `json:"referenced_declaration,omitempty"`
 	TypeDescription       *TypeDescription      `json:"type_description"`
 	Text                  string                `json:"text,omitempty"`
+	Documentation         *NatSpec              `json:"documentation,omitempty"`
 }
 
 // NewFunction creates and initializes a new Function node.
@@ -75,6 +76,11 @@ func (f *Function) GetSrc() SrcNode {
 	return f.Src
 }
 
+// GetDocumentation returns the NatSpec documentation of the function, or nil if it is not documented.
+func (f *Function) GetDocumentation() *NatSpec {
+	return f.Documentation
+}
+
 // GetNameLocation returns the source location information of the name of the Function node.
 func (f *Function) GetNameLocation() SrcNode {
 	return f.NameLocation
@@ -244,6 +250,12 @@ func (f *Function) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	if documentation, ok := tempMap["documentation"]; ok {
+		if err := json.Unmarshal(documentation, &f.Documentation); err != nil {
+			return err
+		}
+	}
+
 	if nameLocation, ok := tempMap["name_location"]; ok {
 		if err := json.Unmarshal(nameLocation, &f.NameLocation); err != nil {
 			return err
@@ -412,6 +424,7 @@ func (f *Function) Parse(
 		Length:      int64(ctx.GetStop().GetStop() - ctx.GetStart().GetStart() + 1),
 		ParentIndex: contractNode.GetId(),
 	}
+	f.Documentation = f.getDocumentationFromCtx(ctx)
 
 	// Set function visibility state.
 	f.Visibility = f.getVisibilityFromCtx(ctx)
@@ -424,8 +437,8 @@ func (f *Function) Parse(
 
 	// Set function parameters if they exist.
 	params := NewParameterList(f.ASTBuilder)
-	if len(ctx.AllParameterList()) > 0 {
-		params.Parse(unit, f, ctx.AllParameterList()[0])
+	if ctx.GetArguments() != nil {
+		params.Parse(unit, f, ctx.GetArguments())
 	} else {
 		params.Src = f.Src
 		params.Src.ParentIndex = f.Id
@@ -528,8 +541,8 @@ func (f *Function) ParseTypeName(
 
 	// Set function parameters if they exist.
 	params := NewParameterList(f.ASTBuilder)
-	if len(ctx.AllParameterList()) > 0 {
-		params.Parse(unit, f, ctx.AllParameterList()[0])
+	if ctx.GetArguments() != nil {
+		params.Parse(unit, f, ctx.GetArguments())
 	} else {
 		params.Src = f.Src
 		params.Src.ParentIndex = f.Id
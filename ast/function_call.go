@@ -21,6 +21,7 @@ type FunctionCall struct {
 	Src                   SrcNode            `json:"src"`                              // Source location of the node.
 	ArgumentTypes         []*TypeDescription `json:"argument_types"`                   // Types of the arguments.
 	Arguments             []Node[NodeType]   `json:"arguments"`                        // Arguments of the function call.
+	Names                 []string           `json:"names,omitempty"`                  // Names of the arguments, when called with named arguments.
 	Expression            Node[NodeType]     `json:"expression"`                       // Expression of the function call.
 	ReferencedDeclaration int64              `json:"referenced_declaration,omitempty"` // Referenced declaration of the function call.
 	TypeDescription       *TypeDescription   `json:"type_description"`                 // Type description of the function call.
@@ -86,7 +87,12 @@ func (f *FunctionCall) GetTypeDescription() *TypeDescription {
 	return f.TypeDescription
 }
 
-// GetNodes returns a slice of nodes that includes the expression of the FunctionCall node.
+// GetNames returns the names of the arguments, empty unless the function is called with named arguments.
+func (f *FunctionCall) GetNames() []string {
+	return f.Names
+}
+
+// GetNodes returns a slice of nodes that includes the expression and arguments of the FunctionCall node.
 func (f *FunctionCall) GetNodes() []Node[NodeType] {
 	toReturn := []Node[NodeType]{f.Expression}
 	toReturn = append(toReturn, f.Arguments...)
@@ -157,6 +163,12 @@ func (f *FunctionCall) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	if names, ok := tempMap["names"]; ok {
+		if err := json.Unmarshal(names, &f.Names); err != nil {
+			return err
+		}
+	}
+
 	if arguments, ok := tempMap["arguments"]; ok {
 		f.Arguments = make([]Node[NodeType], 0)
 		var nodes []json.RawMessage
@@ -299,6 +311,13 @@ func (f *FunctionCall) Parse(
 				expr.GetTypeDescription(),
 			)
 		}
+
+		for _, argumentCtx := range ctx.CallArgumentList().AllNamedArgument() {
+			expr := expression.Parse(unit, contractNode, fnNode, bodyNode, nil, f, f.GetId(), argumentCtx.GetValue())
+			f.Names = append(f.Names, argumentCtx.GetName().GetText())
+			f.Arguments = append(f.Arguments, expr)
+			f.ArgumentTypes = append(f.ArgumentTypes, expr.GetTypeDescription())
+		}
 	}
 
 	f.TypeDescription = f.buildTypeDescription()
@@ -356,6 +375,8 @@ type FunctionCallOption struct {
 	Kind                  ast_pb.NodeType  `json:"kind"`                             // Kind of the node.
 	Src                   SrcNode          `json:"src"`                              // Source location of the node.
 	Expression            Node[NodeType]   `json:"expression"`                       // Expression of the function call.
+	Names                 []string         `json:"names,omitempty"`                  // Names of the call options, e.g. value or gas.
+	Options               []Node[NodeType] `json:"options,omitempty"`                // Values of the call options.
 	ReferencedDeclaration int64            `json:"referenced_declaration,omitempty"` // Referenced declaration of the function call.
 	TypeDescription       *TypeDescription `json:"type_description"`                 // Type description of the function call.
 }
@@ -408,9 +429,21 @@ func (f *FunctionCallOption) GetTypeDescription() *TypeDescription {
 	return f.TypeDescription
 }
 
-// GetNodes returns a slice of nodes that includes the expression of the FunctionCallOption node.
+// GetNames returns the names of the call options.
+func (f *FunctionCallOption) GetNames() []string {
+	return f.Names
+}
+
+// GetOptions returns the values of the call options, in the same order as the names.
+func (f *FunctionCallOption) GetOptions() []Node[NodeType] {
+	return f.Options
+}
+
+// GetNodes returns a slice of nodes that includes the expression and options of the FunctionCallOption node.
 func (f *FunctionCallOption) GetNodes() []Node[NodeType] {
-	return []Node[NodeType]{f.Expression}
+	toReturn := []Node[NodeType]{f.Expression}
+	toReturn = append(toReturn, f.Options...)
+	return toReturn
 }
 
 // GetReferenceDeclaration returns the referenced declaration of the FunctionCallOption node.
@@ -481,6 +514,38 @@ func (f *FunctionCallOption) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	if names, ok := tempMap["names"]; ok {
+		if err := json.Unmarshal(names, &f.Names); err != nil {
+			return err
+		}
+	}
+
+	if options, ok := tempMap["options"]; ok {
+		f.Options = make([]Node[NodeType], 0)
+		var nodes []json.RawMessage
+		if err := json.Unmarshal(options, &nodes); err != nil {
+			return err
+		}
+
+		for _, tempNode := range nodes {
+			var tempNodeMap map[string]json.RawMessage
+			if err := json.Unmarshal(tempNode, &tempNodeMap); err != nil {
+				return err
+			}
+
+			var tempNodeType ast_pb.NodeType
+			if err := json.Unmarshal(tempNodeMap["node_type"], &tempNodeType); err != nil {
+				return err
+			}
+
+			node, err := unmarshalNode(tempNode, tempNodeType)
+			if err != nil {
+				return err
+			}
+			f.Options = append(f.Options, node)
+		}
+	}
+
 	return nil
 }
 
@@ -552,5 +617,12 @@ func (f *FunctionCallOption) Parse(
 		f.TypeDescription = f.Expression.GetTypeDescription()
 	}
 
+	for _, optionCtx := range ctx.AllNamedArgument() {
+		f.Names = append(f.Names, optionCtx.GetName().GetText())
+		f.Options = append(f.Options, expression.Parse(
+			unit, contractNode, fnNode, bodyNode, nil, f, f.GetId(), optionCtx.GetValue(),
+		))
+	}
+
 	return f
 }
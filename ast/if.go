@@ -17,6 +17,7 @@ type IfStatement struct {
 	Src       SrcNode         `json:"src"`       // Source location information.
 	Condition Node[NodeType]  `json:"condition"` // Condition node.
 	Body      Node[NodeType]  `json:"body"`      // Body node.
+	Else      Node[NodeType]  `json:"else"`      // Else body node, nil when there is no else branch.
 }
 
 // NewIfStatement creates a new instance of IfStatement with the provided ASTBuilder.
@@ -63,7 +64,11 @@ func (i *IfStatement) GetTypeDescription() *TypeDescription {
 
 // GetNodes returns a list of nodes associated with the if statement (condition and body).
 func (i *IfStatement) GetNodes() []Node[NodeType] {
-	return []Node[NodeType]{i.Condition, i.Body}
+	toReturn := []Node[NodeType]{i.Condition, i.Body}
+	if i.Else != nil {
+		toReturn = append(toReturn, i.Else)
+	}
+	return toReturn
 }
 
 // GetBody returns the body node of the if statement.
@@ -71,6 +76,11 @@ func (i *IfStatement) GetBody() Node[NodeType] {
 	return i.Body
 }
 
+// GetElse returns the else body node of the if statement, or nil when there is no else branch.
+func (i *IfStatement) GetElse() Node[NodeType] {
+	return i.Else
+}
+
 // UnmarshalJSON unmarshals the JSON data into a IfStatement.
 func (i *IfStatement) UnmarshalJSON(data []byte) error {
 	var tempMap map[string]json.RawMessage
@@ -136,6 +146,26 @@ func (i *IfStatement) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	if elseBody, ok := tempMap["else"]; ok {
+		var tempNodeMap map[string]json.RawMessage
+		if err := json.Unmarshal(elseBody, &tempNodeMap); err != nil {
+			return err
+		}
+
+		if tempNodeMap != nil {
+			var tempNodeType ast_pb.NodeType
+			if err := json.Unmarshal(tempNodeMap["node_type"], &tempNodeType); err != nil {
+				return err
+			}
+
+			node, err := unmarshalNode(elseBody, tempNodeType)
+			if err != nil {
+				return err
+			}
+			i.Else = node
+		}
+	}
+
 	return nil
 }
 
@@ -175,25 +205,50 @@ func (i *IfStatement) Parse(
 
 	i.Condition = expression.Parse(unit, contractNode, fnNode, bodyNode, nil, i, i.GetId(), ctx.Expression())
 
+	statements := ctx.AllStatement()
+	if len(statements) > 0 {
+		i.Body = i.parseBranch(unit, contractNode, fnNode, statements[0])
+	} else {
+		i.Body = NewBodyNode(i.ASTBuilder, false)
+	}
+
+	// Second statement, if present, is the else branch. Else if is represented as an else
+	// branch holding a single if statement.
+	if len(statements) > 1 {
+		i.Else = i.parseBranch(unit, contractNode, fnNode, statements[1])
+	}
+
+	return i
+}
+
+// parseBranch parses the body of the if or else branch. Single statement branches,
+// such as `if (a) return;`, are wrapped into a body node.
+func (i *IfStatement) parseBranch(
+	unit *SourceUnit[Node[ast_pb.SourceUnit]],
+	contractNode Node[NodeType],
+	fnNode Node[NodeType],
+	ctx parser.IStatementContext,
+) *BodyNode {
 	body := NewBodyNode(i.ASTBuilder, false)
-	if len(ctx.AllStatement()) > 0 {
-		for _, statementCtx := range ctx.AllStatement() {
-			if statementCtx.IsEmpty() {
-				continue
-			}
 
-			if statementCtx.Block() != nil {
-				body.ParseBlock(unit, contractNode, fnNode, statementCtx.Block())
-				break
-			}
+	if ctx.Block() != nil {
+		body.ParseBlock(unit, contractNode, fnNode, ctx.Block())
+		return body
+	}
 
-			i.Body = body
-		}
+	body.Src = SrcNode{
+		Line:        int64(ctx.GetStart().GetLine()),
+		Column:      int64(ctx.GetStart().GetColumn()),
+		Start:       int64(ctx.GetStart().GetStart()),
+		End:         int64(ctx.GetStop().GetStop()),
+		Length:      int64(ctx.GetStop().GetStop() - ctx.GetStart().GetStart() + 1),
+		ParentIndex: i.GetId(),
+	}
+	body.Implemented = true
 
-		i.Body = body
-	} else {
-		i.Body = body
+	for _, child := range ctx.GetChildren() {
+		body.parseStatements(unit, contractNode, fnNode, child)
 	}
 
-	return i
+	return body
 }
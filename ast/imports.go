@@ -211,10 +211,6 @@ func parseImportPathsForSourceUnit(
 				importNode.UnitAlias = importCtx.GetUnitAlias().GetText()
 			}
 
-			if importCtx.As() != nil {
-				importNode.UnitAlias = importCtx.As().GetText()
-			}
-
 			if importCtx.SymbolAliases() != nil {
 				for _, aliasCtx := range importCtx.SymbolAliases().AllImportAliases() {
 					if aliasCtx.GetAlias() != nil {
@@ -15,8 +15,9 @@ type IndexRange struct {
 	Id               int64              `json:"id"`
 	NodeType         ast_pb.NodeType    `json:"node_type"`
 	Src              SrcNode            `json:"src"`
-	LeftExpression   Node[NodeType]     `json:"left_expression"`
-	RightExpression  Node[NodeType]     `json:"right_expression"`
+	BaseExpression   Node[NodeType]     `json:"base_expression"`  // Expression being sliced.
+	LeftExpression   Node[NodeType]     `json:"left_expression"`  // Start of the range, nil when omitted.
+	RightExpression  Node[NodeType]     `json:"right_expression"` // End of the range, nil when omitted.
 	TypeDescriptions []*TypeDescription `json:"type_descriptions"`
 }
 
@@ -58,7 +59,18 @@ func (f *IndexRange) GetTypeDescription() *TypeDescription {
 
 // GetNodes returns the list of nodes within the IndexRange.
 func (f *IndexRange) GetNodes() []Node[NodeType] {
-	return []Node[NodeType]{f.LeftExpression, f.RightExpression}
+	toReturn := make([]Node[NodeType], 0)
+	for _, node := range []Node[NodeType]{f.BaseExpression, f.LeftExpression, f.RightExpression} {
+		if node != nil {
+			toReturn = append(toReturn, node)
+		}
+	}
+	return toReturn
+}
+
+// GetBaseExpression returns the expression being sliced by the IndexRange.
+func (f *IndexRange) GetBaseExpression() Node[NodeType] {
+	return f.BaseExpression
 }
 
 // GetLeftExpression returns the left expression of the IndexRange.
@@ -95,6 +107,26 @@ func (f *IndexRange) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	if baseExpression, ok := tempMap["base_expression"]; ok {
+		var tempNodeMap map[string]json.RawMessage
+		if err := json.Unmarshal(baseExpression, &tempNodeMap); err != nil {
+			return err
+		}
+
+		if tempNodeMap != nil {
+			var tempNodeType ast_pb.NodeType
+			if err := json.Unmarshal(tempNodeMap["node_type"], &tempNodeType); err != nil {
+				return err
+			}
+
+			node, err := unmarshalNode(baseExpression, tempNodeType)
+			if err != nil {
+				return err
+			}
+			f.BaseExpression = node
+		}
+	}
+
 	if leftExpression, ok := tempMap["left_expression"]; ok {
 		if err := json.Unmarshal(leftExpression, &f.LeftExpression); err != nil {
 			var tempNodeMap map[string]json.RawMessage
@@ -150,11 +182,17 @@ func (f *IndexRange) ToProto() NodeType {
 		Id:              f.GetId(),
 		NodeType:        f.GetType(),
 		Src:             f.GetSrc().ToProto(),
-		LeftExpression:  f.GetLeftExpression().ToProto().(*v3.TypedStruct),
-		RightExpression: f.GetRightExpression().ToProto().(*v3.TypedStruct),
 		TypeDescription: f.GetTypeDescription().ToProto(),
 	}
 
+	if f.GetLeftExpression() != nil {
+		proto.LeftExpression = f.GetLeftExpression().ToProto().(*v3.TypedStruct)
+	}
+
+	if f.GetRightExpression() != nil {
+		proto.RightExpression = f.GetRightExpression().ToProto().(*v3.TypedStruct)
+	}
+
 	return NewTypedStruct(&proto, "IndexRange")
 }
 
@@ -198,11 +236,19 @@ func (f *IndexRange) Parse(
 
 	expression := NewExpression(f.ASTBuilder)
 
-	f.LeftExpression = expression.Parse(unit, contractNode, fnNode, bodyNode, vDeclar, f, f.GetId(), ctx.Expression(0))
-	f.RightExpression = expression.Parse(unit, contractNode, fnNode, bodyNode, vDeclar, f, f.GetId(), ctx.Expression(1))
+	f.BaseExpression = expression.Parse(unit, contractNode, fnNode, bodyNode, vDeclar, f, f.GetId(), ctx.Expression(0))
+	f.TypeDescriptions = append(f.TypeDescriptions, f.BaseExpression.GetTypeDescription())
 
-	f.TypeDescriptions = append(f.TypeDescriptions, f.LeftExpression.GetTypeDescription())
-	f.TypeDescriptions = append(f.TypeDescriptions, f.RightExpression.GetTypeDescription())
+	// Both start and end of the range are optional, e.g. `msg.data[4:]`.
+	if ctx.GetStartIndex() != nil {
+		f.LeftExpression = expression.Parse(unit, contractNode, fnNode, bodyNode, vDeclar, f, f.GetId(), ctx.GetStartIndex())
+		f.TypeDescriptions = append(f.TypeDescriptions, f.LeftExpression.GetTypeDescription())
+	}
+
+	if ctx.GetEndIndex() != nil {
+		f.RightExpression = expression.Parse(unit, contractNode, fnNode, bodyNode, vDeclar, f, f.GetId(), ctx.GetEndIndex())
+		f.TypeDescriptions = append(f.TypeDescriptions, f.RightExpression.GetTypeDescription())
+	}
 
 	return f
 }
@@ -27,6 +27,7 @@ type Interface struct {
 	LinearizedBaseContracts []int64          `json:"linearized_base_contracts"` // List of linearized base contract identifiers.
 	BaseContracts           []*BaseContract  `json:"base_contracts"`            // List of base contracts.
 	ContractDependencies    []int64          `json:"contract_dependencies"`     // List of contract dependency identifiers.
+	Documentation           *NatSpec         `json:"documentation,omitempty"`   // NatSpec documentation of the interface.
 }
 
 // NewInterfaceDefinition creates a new Interface node with default values and returns it.
@@ -63,6 +64,11 @@ func (l *Interface) GetSrc() SrcNode {
 	return l.Src
 }
 
+// GetDocumentation returns the NatSpec documentation of the interface, or nil if it is not documented.
+func (l *Interface) GetDocumentation() *NatSpec {
+	return l.Documentation
+}
+
 // GetNameLocation returns the location of the interface name.
 func (l *Interface) GetNameLocation() SrcNode {
 	return l.NameLocation
@@ -257,6 +263,12 @@ func (l *Interface) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	if documentation, ok := tempMap["documentation"]; ok {
+		if err := json.Unmarshal(documentation, &l.Documentation); err != nil {
+			return err
+		}
+	}
+
 	if nameLocation, ok := tempMap["name_location"]; ok {
 		if err := json.Unmarshal(nameLocation, &l.NameLocation); err != nil {
 			return err
@@ -385,8 +397,9 @@ func (l *Interface) Parse(unitCtx *parser.SourceUnitContext, ctx *parser.Interfa
 
 	interfaceId := l.GetNextID()
 	interfaceNode := &Interface{
-		Id:   interfaceId,
-		Name: ctx.Identifier().GetText(),
+		Id:            interfaceId,
+		Name:          ctx.Identifier().GetText(),
+		Documentation: l.getDocumentationFromCtx(ctx),
 		Src: SrcNode{
 			Line:        int64(ctx.GetStart().GetLine()),
 			Column:      int64(ctx.GetStart().GetColumn()),
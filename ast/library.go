@@ -28,6 +28,7 @@ type Library struct {
 	LinearizedBaseContracts []int64          `json:"linearized_base_contracts"` // LinearizedBaseContracts are the linearized base contracts of the library.
 	BaseContracts           []*BaseContract  `json:"base_contracts"`            // BaseContracts are the base contracts of the library.
 	ContractDependencies    []int64          `json:"contract_dependencies"`     // ContractDependencies are the contract dependencies of the library.
+	Documentation           *NatSpec         `json:"documentation,omitempty"`   // Documentation is the NatSpec documentation of the library.
 }
 
 // NewLibraryDefinition creates a new Library with the provided ASTBuilder.
@@ -64,6 +65,11 @@ func (l *Library) GetSrc() SrcNode {
 	return l.Src
 }
 
+// GetDocumentation returns the NatSpec documentation of the library, or nil if it is not documented.
+func (l *Library) GetDocumentation() *NatSpec {
+	return l.Documentation
+}
+
 // GetNameLocation returns the source node associated with the name of the library node.
 func (l *Library) GetNameLocation() SrcNode {
 	return l.NameLocation
@@ -259,6 +265,12 @@ func (l *Library) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	if documentation, ok := tempMap["documentation"]; ok {
+		if err := json.Unmarshal(documentation, &l.Documentation); err != nil {
+			return err
+		}
+	}
+
 	if nameLocation, ok := tempMap["name_location"]; ok {
 		if err := json.Unmarshal(nameLocation, &l.NameLocation); err != nil {
 			return err
@@ -395,8 +407,9 @@ func (l *Library) Parse(unitCtx *parser.SourceUnitContext, ctx *parser.LibraryDe
 
 	libraryId := l.GetNextID()
 	libraryNode := &Library{
-		Id:   libraryId,
-		Name: ctx.Identifier().GetText(),
+		Id:            libraryId,
+		Name:          ctx.Identifier().GetText(),
+		Documentation: l.getDocumentationFromCtx(ctx),
 		Src: SrcNode{
 			Line:        int64(ctx.GetStart().GetLine()),
 			Column:      int64(ctx.GetStart().GetColumn()),
@@ -110,9 +110,9 @@ func (m *MetaType) Parse(unit *SourceUnit[Node[ast_pb.SourceUnit]],
 		}(),
 	}
 
-	m.Name = ctx.Type().GetText()
+	m.Name = ctx.TypeName().GetText()
 	m.TypeDescription = &TypeDescription{
-		TypeString: ctx.Type().GetText(),
+		TypeString: "type(" + m.Name + ")",
 	}
 
 	return m
@@ -0,0 +1,224 @@
+package ast
+
+import (
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// NatSpec represents the NatSpec documentation attached to contracts, functions, events,
+// errors, modifiers and state variables.
+type NatSpec struct {
+	Text       string            `json:"text"`                 // Documentation text without the comment markers.
+	Title      string            `json:"title,omitempty"`      // Value of the @title tag.
+	Author     string            `json:"author,omitempty"`     // Value of the @author tag.
+	Notice     string            `json:"notice,omitempty"`     // Value of the @notice tag or untagged text.
+	Dev        string            `json:"dev,omitempty"`        // Value of the @dev tag.
+	Params     map[string]string `json:"params,omitempty"`     // Values of the @param tags keyed by parameter name.
+	Returns    []string          `json:"returns,omitempty"`    // Values of the @return tags in the order of appearance.
+	InheritDoc string            `json:"inheritdoc,omitempty"` // Name of the contract referenced by the @inheritdoc tag.
+	Custom     map[string]string `json:"custom,omitempty"`     // Values of the @custom:* tags keyed by the tag name without the prefix.
+}
+
+// GetText returns the documentation text without the comment markers.
+func (n *NatSpec) GetText() string {
+	return n.Text
+}
+
+// GetTitle returns the value of the @title tag.
+func (n *NatSpec) GetTitle() string {
+	return n.Title
+}
+
+// GetAuthor returns the value of the @author tag.
+func (n *NatSpec) GetAuthor() string {
+	return n.Author
+}
+
+// GetNotice returns the value of the @notice tag.
+func (n *NatSpec) GetNotice() string {
+	return n.Notice
+}
+
+// GetDev returns the value of the @dev tag.
+func (n *NatSpec) GetDev() string {
+	return n.Dev
+}
+
+// GetParams returns the values of the @param tags keyed by parameter name.
+func (n *NatSpec) GetParams() map[string]string {
+	return n.Params
+}
+
+// GetParam returns the value of the @param tag for the given parameter name.
+func (n *NatSpec) GetParam(name string) string {
+	return n.Params[name]
+}
+
+// GetReturns returns the values of the @return tags.
+func (n *NatSpec) GetReturns() []string {
+	return n.Returns
+}
+
+// GetInheritDoc returns the name of the contract referenced by the @inheritdoc tag.
+func (n *NatSpec) GetInheritDoc() string {
+	return n.InheritDoc
+}
+
+// GetCustom returns the values of the @custom:* tags.
+func (n *NatSpec) GetCustom() map[string]string {
+	return n.Custom
+}
+
+// IsEmpty returns whether the documentation holds no tags.
+func (n *NatSpec) IsEmpty() bool {
+	return n.Title == "" && n.Author == "" && n.Notice == "" && n.Dev == "" &&
+		len(n.Params) == 0 && len(n.Returns) == 0 && n.InheritDoc == "" && len(n.Custom) == 0
+}
+
+// ParseNatSpec parses the NatSpec documentation from the provided comments. Comments can be
+// passed with the comment markers (`///` or `/** */`) or already stripped of them, as found in
+// the solc AST. Untagged text is treated as @notice, same as the compiler does.
+func ParseNatSpec(comments ...string) *NatSpec {
+	lines := make([]string, 0)
+	for _, comment := range comments {
+		lines = append(lines, natSpecLines(comment)...)
+	}
+
+	toReturn := &NatSpec{
+		Text:   strings.Join(lines, "\n"),
+		Params: make(map[string]string),
+		Custom: make(map[string]string),
+	}
+
+	// Content of the tag continues on the following lines until the next tag is found.
+	// Untagged text at the beginning of the comment is the notice.
+	tag, key := "notice", ""
+	appendContent := func(separator string, content string) {
+		if content == "" {
+			return
+		}
+
+		join := func(value string) string {
+			if value == "" {
+				return content
+			}
+			return value + separator + content
+		}
+
+		switch tag {
+		case "title":
+			toReturn.Title = join(toReturn.Title)
+		case "author":
+			toReturn.Author = join(toReturn.Author)
+		case "notice":
+			toReturn.Notice = join(toReturn.Notice)
+		case "dev":
+			toReturn.Dev = join(toReturn.Dev)
+		case "inheritdoc":
+			toReturn.InheritDoc = join(toReturn.InheritDoc)
+		case "param":
+			toReturn.Params[key] = join(toReturn.Params[key])
+		case "return":
+			toReturn.Returns[len(toReturn.Returns)-1] = join(toReturn.Returns[len(toReturn.Returns)-1])
+		case "custom":
+			toReturn.Custom[key] = join(toReturn.Custom[key])
+		}
+	}
+
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+
+		if !strings.HasPrefix(line, "@") {
+			appendContent(" ", line)
+			continue
+		}
+
+		name, content := splitNatSpecWord(line[1:])
+
+		switch {
+		case name == "param":
+			tag = name
+			key, content = splitNatSpecWord(content)
+			toReturn.Params[key] = ""
+		case name == "return":
+			tag = name
+			toReturn.Returns = append(toReturn.Returns, "")
+		case strings.HasPrefix(name, "custom:"):
+			tag, key = "custom", strings.TrimPrefix(name, "custom:")
+			toReturn.Custom[key] = ""
+		case name == "title", name == "author", name == "notice", name == "dev", name == "inheritdoc":
+			tag = name
+		default:
+			// Unknown tags are not valid NatSpec and their content is ignored.
+			tag = ""
+		}
+
+		appendContent("\n", content)
+	}
+
+	return toReturn
+}
+
+// natSpecLines returns the lines of the comment without the comment markers and leading asterisks.
+func natSpecLines(comment string) []string {
+	comment = strings.TrimSpace(comment)
+	toReturn := make([]string, 0)
+
+	switch {
+	case strings.HasPrefix(comment, "///"):
+		for _, line := range strings.Split(comment, "\n") {
+			line = strings.TrimSpace(line)
+			toReturn = append(toReturn, strings.TrimSpace(strings.TrimPrefix(line, "///")))
+		}
+	case strings.HasPrefix(comment, "/**"):
+		comment = strings.TrimSuffix(strings.TrimPrefix(comment, "/**"), "*/")
+		for _, line := range strings.Split(comment, "\n") {
+			line = strings.TrimSpace(line)
+			toReturn = append(toReturn, strings.TrimSpace(strings.TrimPrefix(line, "*")))
+		}
+	default:
+		for _, line := range strings.Split(comment, "\n") {
+			toReturn = append(toReturn, strings.TrimSpace(line))
+		}
+	}
+
+	// Leading and trailing empty lines are not part of the documentation.
+	for len(toReturn) > 0 && toReturn[0] == "" {
+		toReturn = toReturn[1:]
+	}
+	for len(toReturn) > 0 && toReturn[len(toReturn)-1] == "" {
+		toReturn = toReturn[:len(toReturn)-1]
+	}
+
+	return toReturn
+}
+
+// splitNatSpecWord splits the text into the first word and the rest of the text.
+func splitNatSpecWord(text string) (string, string) {
+	text = strings.TrimSpace(text)
+	if index := strings.IndexAny(text, " \t"); index >= 0 {
+		return text[:index], strings.TrimSpace(text[index+1:])
+	}
+	return text, ""
+}
+
+// isNatSpecComment returns whether the comment is a NatSpec comment, meaning `///` line comment
+// or `/** */` block comment.
+func isNatSpecComment(text string) bool {
+	if strings.HasPrefix(text, "///") {
+		return !strings.HasPrefix(text, "////")
+	}
+	return strings.HasPrefix(text, "/**") && !strings.HasPrefix(text, "/***") && text != "/**/"
+}
+
+// getDocumentationFromCtx returns the NatSpec documentation preceding the provided context, or nil
+// if the definition is not documented.
+func (b *ASTBuilder) getDocumentationFromCtx(ctx antlr.ParserRuleContext) *NatSpec {
+	if ctx == nil || ctx.GetStart() == nil {
+		return nil
+	}
+	return b.natSpecs[int64(ctx.GetStart().GetStart())]
+}
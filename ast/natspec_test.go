@@ -0,0 +1,173 @@
+package ast
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unpackdev/solgo"
+)
+
+func TestParseNatSpec(t *testing.T) {
+	testCases := []struct {
+		name     string
+		comments []string
+		expected *NatSpec
+	}{
+		{
+			name:     "Untagged line comment",
+			comments: []string{"/// Transfers the tokens"},
+			expected: &NatSpec{
+				Text:   "Transfers the tokens",
+				Notice: "Transfers the tokens",
+			},
+		},
+		{
+			name: "Multiple line comments",
+			comments: []string{
+				"/// @notice Transfers the tokens",
+				"/// @dev Multiplies a number by 7",
+				"/// and adds second parameter",
+				"/// @param to Receiver of the tokens",
+				"/// @param amount Amount of the tokens",
+				"/// @return success Whether the transfer succeeded",
+			},
+			expected: &NatSpec{
+				Text:    "@notice Transfers the tokens\n@dev Multiplies a number by 7\nand adds second parameter\n@param to Receiver of the tokens\n@param amount Amount of the tokens\n@return success Whether the transfer succeeded",
+				Notice:  "Transfers the tokens",
+				Dev:     "Multiplies a number by 7 and adds second parameter",
+				Params:  map[string]string{"to": "Receiver of the tokens", "amount": "Amount of the tokens"},
+				Returns: []string{"success Whether the transfer succeeded"},
+			},
+		},
+		{
+			name: "Block comment",
+			comments: []string{`/**
+     * @title Token
+     * @author Solgo
+     * @custom:security-contact security@example.com
+     * @inheritdoc IERC20
+     */`},
+			expected: &NatSpec{
+				Text:       "@title Token\n@author Solgo\n@custom:security-contact security@example.com\n@inheritdoc IERC20",
+				Title:      "Token",
+				Author:     "Solgo",
+				InheritDoc: "IERC20",
+				Custom:     map[string]string{"security-contact": "security@example.com"},
+			},
+		},
+		{
+			name:     "Solc documentation text",
+			comments: []string{"@notice Returns the balance\n @return Balance of the owner"},
+			expected: &NatSpec{
+				Text:    "@notice Returns the balance\n@return Balance of the owner",
+				Notice:  "Returns the balance",
+				Returns: []string{"Balance of the owner"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			natSpec := ParseNatSpec(testCase.comments...)
+			assert.Equal(t, testCase.expected.Text, natSpec.GetText())
+			assert.Equal(t, testCase.expected.Title, natSpec.GetTitle())
+			assert.Equal(t, testCase.expected.Author, natSpec.GetAuthor())
+			assert.Equal(t, testCase.expected.Notice, natSpec.GetNotice())
+			assert.Equal(t, testCase.expected.Dev, natSpec.GetDev())
+			assert.Equal(t, testCase.expected.InheritDoc, natSpec.GetInheritDoc())
+			assert.Equal(t, testCase.expected.Returns, natSpec.GetReturns())
+			assert.Equal(t, len(testCase.expected.Params), len(natSpec.GetParams()))
+			for name, description := range testCase.expected.Params {
+				assert.Equal(t, description, natSpec.GetParam(name))
+			}
+			assert.Equal(t, len(testCase.expected.Custom), len(natSpec.GetCustom()))
+			for name, description := range testCase.expected.Custom {
+				assert.Equal(t, description, natSpec.GetCustom()[name])
+			}
+		})
+	}
+}
+
+func TestNatSpecAttachment(t *testing.T) {
+	content := `// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+/// @title Token
+/// @notice Simple token
+contract Token {
+    /// @notice Balances of the holders
+    mapping(address => uint256) public balances;
+
+    /// @notice Emitted on transfer
+    event Transfer(address indexed from, address indexed to, uint256 value);
+
+    /// @notice Thrown when the balance is too low
+    error Insufficient(uint256 available);
+
+    // Regular comment is not the documentation.
+    modifier positive(uint256 value) {
+        _;
+    }
+
+    /**
+     * @notice Transfers the tokens
+     * @param to Receiver of the tokens
+     */
+    function transfer(address to, uint256 value) external positive(value) returns (bool) {
+        return true;
+    }
+}
+`
+
+	parser, err := solgo.NewParser(context.TODO(), strings.NewReader(content))
+	require.NoError(t, err)
+
+	builder := NewAstBuilder(parser.GetParser(), &solgo.Sources{
+		SourceUnits: []*solgo.SourceUnit{
+			{
+				Name:    "Token",
+				Path:    "Token.sol",
+				Content: content,
+			},
+		},
+		EntrySourceUnitName: "Token",
+	})
+	require.NoError(t, parser.RegisterListener(solgo.ListenerAst, builder))
+	require.Empty(t, parser.Parse())
+	require.Empty(t, builder.ResolveReferences())
+
+	root := builder.GetRoot()
+	require.Len(t, root.GetSourceUnits(), 1)
+
+	contract, ok := root.GetSourceUnits()[0].GetContract().(*Contract)
+	require.True(t, ok)
+	require.NotNil(t, contract.GetDocumentation())
+	assert.Equal(t, "Token", contract.GetDocumentation().GetTitle())
+	assert.Equal(t, "Simple token", contract.GetDocumentation().GetNotice())
+
+	require.Len(t, contract.GetStateVariables(), 1)
+	require.NotNil(t, contract.GetStateVariables()[0].GetDocumentation())
+	assert.Equal(t, "Balances of the holders", contract.GetStateVariables()[0].GetDocumentation().GetNotice())
+
+	require.Len(t, contract.GetEvents(), 1)
+	require.NotNil(t, contract.GetEvents()[0].GetDocumentation())
+	assert.Equal(t, "Emitted on transfer", contract.GetEvents()[0].GetDocumentation().GetNotice())
+
+	require.Len(t, contract.GetErrors(), 1)
+	require.NotNil(t, contract.GetErrors()[0].GetDocumentation())
+	assert.Equal(t, "Thrown when the balance is too low", contract.GetErrors()[0].GetDocumentation().GetNotice())
+
+	for _, node := range contract.GetNodes() {
+		if modifier, ok := node.(*ModifierDefinition); ok {
+			assert.Nil(t, modifier.GetDocumentation())
+		}
+	}
+
+	require.Len(t, contract.GetFunctions(), 1)
+	require.NotNil(t, contract.GetFunctions()[0].GetDocumentation())
+	assert.Equal(t, "Transfers the tokens", contract.GetFunctions()[0].GetDocumentation().GetNotice())
+	assert.Equal(t, "Receiver of the tokens", contract.GetFunctions()[0].GetDocumentation().GetParam("to"))
+}
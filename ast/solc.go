@@ -0,0 +1,660 @@
+package ast
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-json"
+	ast_pb "github.com/unpackdev/protos/dist/go/ast"
+)
+
+// solcNode is a lazily decoded node of the solc compact JSON AST.
+// Values are decoded on access as solc reuses the same keys with different shapes
+// across node types (e.g. `value`, `parameters` and `overrides`).
+type solcNode map[string]json.RawMessage
+
+// solcImporter converts solc compact JSON AST nodes into solgo AST nodes.
+type solcImporter struct {
+	*ASTBuilder
+
+	// files maps solc source indexes (third component of `src`) to absolute paths.
+	files map[int64]string
+
+	// contents and lineStarts hold source code and byte offsets of line starts per solc source index.
+	// They are only populated when source contents are available in the builder.
+	contents   map[int64]string
+	lineStarts map[int64][]int64
+
+	// unitName is the name of the source unit currently being converted, used for canonical names.
+	unitName string
+
+	// unitsByContract maps contract declaration ids to solgo source unit ids.
+	unitsByContract map[int64]int64
+
+	// unitsByFile maps solc source unit ids to the first solgo source unit created from that file.
+	unitsByFile map[int64]int64
+
+	// functionsByReturnParams maps return parameter list ids to their function ids.
+	// Solgo references the function from return statements, solc the parameter list.
+	functionsByReturnParams map[int64]int64
+}
+
+// ImportFromSolcJSON imports the AST produced by the solc compiler.
+// It accepts the standard-json output (`sources[*].ast`), a single compact AST source unit
+// or the output of `solc --ast-compact-json`. Node ids and referenced declarations are taken
+// from the compiler, so no additional reference resolution is required.
+// When the builder has sources attached, line and column information is computed from them.
+func (b *ASTBuilder) ImportFromSolcJSON(ctx context.Context, jsonBytes []byte) (*RootNode, error) {
+	documents, files, err := parseSolcDocuments(jsonBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(documents) == 0 {
+		return nil, errors.New("no solc source units found in provided json")
+	}
+
+	// Synthetic nodes, such as source units, must never collide with the compiler ids.
+	if maxId := maxSolcNodeId(jsonBytes); maxId >= b.nextID {
+		b.nextID = maxId + 1
+	}
+
+	if b.tree == nil {
+		b.tree = NewTree(b)
+	}
+
+	importer := &solcImporter{
+		ASTBuilder:              b,
+		files:                   files,
+		contents:                make(map[int64]string),
+		lineStarts:              make(map[int64][]int64),
+		unitsByContract:         make(map[int64]int64),
+		unitsByFile:             make(map[int64]int64),
+		functionsByReturnParams: make(map[int64]int64),
+	}
+
+	for _, document := range documents {
+		if _, index, ok := parseSolcSrc(document.str("src")); ok {
+			if _, exists := importer.files[index]; !exists {
+				importer.files[index] = document.str("absolutePath")
+			}
+		}
+	}
+	importer.loadLineStarts()
+
+	rootNode := NewRootNode(b, 0, make([]*SourceUnit[Node[ast_pb.SourceUnit]], 0), make([]*Comment, 0))
+
+	for _, document := range documents {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		units, globals, err := importer.sourceUnit(rootNode, document)
+		if err != nil {
+			return nil, err
+		}
+
+		rootNode.SourceUnits = append(rootNode.SourceUnits, units...)
+		rootNode.Globals = append(rootNode.Globals, globals...)
+	}
+
+	importer.linkSourceUnits(rootNode)
+	rootNode.SetEntrySourceUnit(importer.entrySourceUnit(rootNode))
+
+	b.sourceUnits = rootNode.SourceUnits
+	b.tree.SetRoot(rootNode)
+
+	return rootNode, nil
+}
+
+// parseSolcDocuments extracts solc source unit nodes from the provided json and returns them
+// together with the source index to path mapping, when one is provided by the compiler output.
+func parseSolcDocuments(jsonBytes []byte) ([]solcNode, map[int64]string, error) {
+	files := make(map[int64]string)
+	documents := make([]solcNode, 0)
+
+	for _, section := range splitSolcSections(jsonBytes) {
+		var node solcNode
+		if err := json.Unmarshal(section, &node); err != nil {
+			return nil, nil, fmt.Errorf("failed to decode solc ast: %w", err)
+		}
+
+		if node.nodeType() == "SourceUnit" {
+			documents = append(documents, node)
+			continue
+		}
+
+		sources := node.node("sources")
+		if sources == nil {
+			return nil, nil, errors.New("provided json is neither solc standard-json output nor a compact ast source unit")
+		}
+
+		// Standard JSON output is a map and map ordering is not guaranteed, so we are going
+		// to keep the compiler source index ordering.
+		type indexedSource struct {
+			index int64
+			node  solcNode
+		}
+
+		indexed := make([]indexedSource, 0, len(sources))
+		for path, raw := range sources {
+			var source solcNode
+			if err := json.Unmarshal(raw, &source); err != nil {
+				return nil, nil, fmt.Errorf("failed to decode solc source %s: %w", path, err)
+			}
+
+			unit := source.node("ast")
+			if unit == nil {
+				unit = source.node("AST")
+			}
+
+			if unit == nil {
+				return nil, nil, fmt.Errorf("solc source %s does not contain an ast", path)
+			}
+
+			files[source.int("id")] = path
+			indexed = append(indexed, indexedSource{index: source.int("id"), node: unit})
+		}
+
+		sort.SliceStable(indexed, func(i, j int) bool {
+			return indexed[i].index < indexed[j].index
+		})
+
+		for _, source := range indexed {
+			documents = append(documents, source.node)
+		}
+	}
+
+	return documents, files, nil
+}
+
+// splitSolcSections splits `solc --ast-compact-json` output into separate json documents.
+// The compiler prints a `======= <path> =======` header before each of the source units.
+// Plain json input is returned as a single section.
+func splitSolcSections(jsonBytes []byte) [][]byte {
+	sections := make([][]byte, 0)
+	current := make([]byte, 0)
+
+	flush := func() {
+		if start := bytes.IndexByte(current, '{'); start >= 0 {
+			sections = append(sections, current[start:])
+		}
+		current = make([]byte, 0)
+	}
+
+	for _, line := range bytes.SplitAfter(jsonBytes, []byte("\n")) {
+		trimmed := bytes.TrimSpace(line)
+		if bytes.HasPrefix(trimmed, []byte("=======")) && bytes.HasSuffix(trimmed, []byte("=======")) {
+			flush()
+			continue
+		}
+		current = append(current, line...)
+	}
+	flush()
+
+	return sections
+}
+
+// maxSolcNodeId returns the highest node id found in the provided solc json.
+func maxSolcNodeId(jsonBytes []byte) int64 {
+	var maxId int64
+
+	var walk func(value interface{})
+	walk = func(value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if id, ok := v["id"].(float64); ok && int64(id) > maxId {
+				maxId = int64(id)
+			}
+			for _, child := range v {
+				walk(child)
+			}
+		case []interface{}:
+			for _, child := range v {
+				walk(child)
+			}
+		}
+	}
+
+	for _, section := range splitSolcSections(jsonBytes) {
+		var value interface{}
+		if err := json.Unmarshal(section, &value); err == nil {
+			walk(value)
+		}
+	}
+
+	return maxId
+}
+
+// loadLineStarts computes line offsets for every known solc source from the builder sources.
+func (i *solcImporter) loadLineStarts() {
+	if i.sources == nil {
+		return
+	}
+
+	for index, path := range i.files {
+		for _, unit := range i.sources.SourceUnits {
+			if !solcPathMatches(path, unit.GetPath(), unit.GetName()) {
+				continue
+			}
+
+			i.contents[index] = unit.GetContent()
+			starts := []int64{0}
+			for offset, char := range []byte(unit.GetContent()) {
+				if char == '\n' {
+					starts = append(starts, int64(offset+1))
+				}
+			}
+			i.lineStarts[index] = starts
+			break
+		}
+	}
+}
+
+// solcPathMatches reports whether the solc absolute path refers to the provided source unit.
+func solcPathMatches(solcPath, unitPath, unitName string) bool {
+	if solcPath == "" {
+		return false
+	}
+
+	if unitPath != "" && (unitPath == solcPath || strings.HasSuffix(filepath.ToSlash(unitPath), "/"+strings.TrimPrefix(solcPath, "./"))) {
+		return true
+	}
+
+	base := filepath.Base(solcPath)
+	return (unitPath != "" && filepath.Base(unitPath) == base) || unitName+".sol" == base
+}
+
+// parseSolcSrc parses solc `start:length:index` source location.
+func parseSolcSrc(src string) (SrcNode, int64, bool) {
+	parts := strings.Split(src, ":")
+	if len(parts) != 3 {
+		return SrcNode{}, 0, false
+	}
+
+	start, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || start < 0 {
+		return SrcNode{}, 0, false
+	}
+
+	length, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || length < 0 {
+		return SrcNode{}, 0, false
+	}
+
+	index, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return SrcNode{}, 0, false
+	}
+
+	end := start + length - 1
+	if length == 0 {
+		end = start
+	}
+
+	return SrcNode{Start: start, End: end, Length: length}, index, true
+}
+
+// src converts solc source location into SrcNode with line and column when sources are available.
+func (i *solcImporter) src(src string, parentIndex int64) SrcNode {
+	toReturn, index, ok := parseSolcSrc(src)
+	if !ok {
+		return SrcNode{ParentIndex: parentIndex}
+	}
+	toReturn.ParentIndex = parentIndex
+
+	if starts, found := i.lineStarts[index]; found {
+		line := sort.Search(len(starts), func(n int) bool {
+			return starts[n] > toReturn.Start
+		})
+		if line > 0 {
+			toReturn.Line = int64(line)
+			toReturn.Column = toReturn.Start - starts[line-1]
+		}
+	}
+
+	return toReturn
+}
+
+// text returns the source code at the solc source location or an empty string
+// when sources are not available.
+func (i *solcImporter) text(src string) string {
+	location, index, ok := parseSolcSrc(src)
+	if !ok {
+		return ""
+	}
+
+	content, found := i.contents[index]
+	if !found || location.Start+location.Length > int64(len(content)) {
+		return ""
+	}
+
+	return content[location.Start : location.Start+location.Length]
+}
+
+// srcPtr converts solc source location into *SrcNode, returning nil for missing locations (`-1:-1:-1`).
+func (i *solcImporter) srcPtr(src string, parentIndex int64) *SrcNode {
+	if _, _, ok := parseSolcSrc(src); !ok {
+		return nil
+	}
+
+	toReturn := i.src(src, parentIndex)
+	return &toReturn
+}
+
+// sourceUnit converts solc SourceUnit into one solgo source unit per contract, library or interface,
+// as solgo does when parsing. File level definitions are returned as global nodes.
+func (i *solcImporter) sourceUnit(rootNode *RootNode, node solcNode) ([]*SourceUnit[Node[ast_pb.SourceUnit]], []Node[NodeType], error) {
+	units := make([]*SourceUnit[Node[ast_pb.SourceUnit]], 0)
+	globals := make([]Node[NodeType], 0)
+	directives := make([]Node[NodeType], 0)
+
+	absolutePath := filepath.Base(filepath.Clean(node.str("absolutePath")))
+	license := node.str("license")
+
+	for _, child := range node.nodes("nodes") {
+		switch child.nodeType() {
+		case "PragmaDirective":
+			directives = append(directives, i.pragma(child, node.int("id")))
+		case "ImportDirective":
+			directives = append(directives, i.importDirective(child, node.int("id")))
+		}
+	}
+
+	for _, child := range node.nodes("nodes") {
+		switch child.nodeType() {
+		case "PragmaDirective", "ImportDirective":
+			continue
+		case "ContractDefinition":
+			unit := NewSourceUnit[Node[ast_pb.SourceUnit]](i.ASTBuilder, child.str("name"), license)
+			if len(units) == 0 {
+				unit.Id = node.int("id")
+				i.unitsByFile[node.int("id")] = unit.Id
+			}
+			unit.AbsolutePath = absolutePath
+			unit.Src = i.src(child.str("src"), rootNode.GetId())
+			unit.ExportedSymbols = append(unit.ExportedSymbols, NewSymbol(unit.Id, unit.Name, unit.AbsolutePath))
+			unit.Nodes = append(unit.Nodes, directives...)
+
+			contract, err := i.contract(unit, child)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			unit.Nodes = append(unit.Nodes, contract)
+			unit.Contract = contract
+			i.unitsByContract[contract.GetId()] = unit.Id
+			units = append(units, unit)
+		default:
+			global, err := i.definition(node.int("id"), child)
+			if err != nil {
+				return nil, nil, err
+			}
+			globals = append(globals, global)
+		}
+	}
+
+	return units, globals, nil
+}
+
+// linkSourceUnits updates base contract and import references to point to solgo source units
+// and fills exported symbols the same way the resolver does for parsed sources.
+func (i *solcImporter) linkSourceUnits(rootNode *RootNode) {
+	for _, unit := range rootNode.GetSourceUnits() {
+		for _, node := range unit.GetNodes() {
+			importNode, ok := node.(*Import)
+			if !ok {
+				continue
+			}
+
+			if unitId, found := i.unitsByFile[importNode.SourceUnit]; found {
+				importNode.SourceUnit = unitId
+			}
+
+			if !i.symbolExists(importNode.GetName(), unit.ExportedSymbols) {
+				unit.ExportedSymbols = append(
+					unit.ExportedSymbols,
+					NewSymbol(importNode.GetSourceUnit(), importNode.GetName(), importNode.GetAbsolutePath()),
+				)
+			}
+		}
+
+		for _, baseContract := range unit.BaseContracts {
+			contractId := baseContract.BaseName.ContractReferencedDeclaration
+			if unitId, found := i.unitsByContract[contractId]; found {
+				baseContract.BaseName.ReferencedDeclaration = unitId
+			}
+
+			if !i.symbolExists(baseContract.BaseName.Name, unit.ExportedSymbols) {
+				symbol := NewSymbol(baseContract.BaseName.ReferencedDeclaration, baseContract.BaseName.Name, "")
+				if baseUnit := rootNode.GetSourceUnitById(baseContract.BaseName.ReferencedDeclaration); baseUnit != nil {
+					symbol.AbsolutePath = baseUnit.AbsolutePath
+				}
+				unit.ExportedSymbols = append(unit.ExportedSymbols, symbol)
+			}
+		}
+	}
+}
+
+// symbolExists checks if a symbol with a given name exists in a list of symbols.
+func (i *solcImporter) symbolExists(name string, symbols []Symbol) bool {
+	for _, symbol := range symbols {
+		if symbol.GetName() == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// entrySourceUnit returns the entry source unit id. It prefers the entry source unit name
+// from the builder sources and falls back to the highest source unit id.
+func (i *solcImporter) entrySourceUnit(rootNode *RootNode) int64 {
+	if i.sources != nil && len(i.sources.EntrySourceUnitName) > 0 {
+		if unit := rootNode.GetSourceUnitByName(i.sources.EntrySourceUnitName); unit != nil {
+			return unit.GetId()
+		}
+	}
+
+	var toReturn int64
+	for _, unit := range rootNode.GetSourceUnits() {
+		if unit.GetId() > toReturn {
+			toReturn = unit.GetId()
+		}
+	}
+
+	return toReturn
+}
+
+// nodeType returns the solc node type.
+func (n solcNode) nodeType() string {
+	return n.str("nodeType")
+}
+
+// has reports whether the key is present and not null.
+func (n solcNode) has(key string) bool {
+	raw, ok := n[key]
+	return ok && len(raw) > 0 && string(raw) != "null"
+}
+
+// str decodes the value under the key as string.
+func (n solcNode) str(key string) string {
+	var toReturn string
+	if n.has(key) {
+		_ = json.Unmarshal(n[key], &toReturn)
+	}
+	return toReturn
+}
+
+// int decodes the value under the key as int64.
+func (n solcNode) int(key string) int64 {
+	var toReturn int64
+	if n.has(key) {
+		_ = json.Unmarshal(n[key], &toReturn)
+	}
+	return toReturn
+}
+
+// boolean decodes the value under the key as bool.
+func (n solcNode) boolean(key string) bool {
+	var toReturn bool
+	if n.has(key) {
+		_ = json.Unmarshal(n[key], &toReturn)
+	}
+	return toReturn
+}
+
+// ints decodes the value under the key as a list of int64. Null entries are skipped.
+func (n solcNode) ints(key string) []int64 {
+	toReturn := make([]int64, 0)
+	if !n.has(key) {
+		return toReturn
+	}
+
+	var values []*int64
+	if err := json.Unmarshal(n[key], &values); err != nil {
+		return toReturn
+	}
+
+	for _, value := range values {
+		if value != nil {
+			toReturn = append(toReturn, *value)
+		}
+	}
+
+	return toReturn
+}
+
+// strs decodes the value under the key as a list of strings.
+func (n solcNode) strs(key string) []string {
+	toReturn := make([]string, 0)
+	if n.has(key) {
+		_ = json.Unmarshal(n[key], &toReturn)
+	}
+	return toReturn
+}
+
+// node decodes the value under the key as a node. It returns nil if the value is not an object.
+func (n solcNode) node(key string) solcNode {
+	if !n.has(key) {
+		return nil
+	}
+
+	var toReturn solcNode
+	if err := json.Unmarshal(n[key], &toReturn); err != nil {
+		return nil
+	}
+	return toReturn
+}
+
+// nodes decodes the value under the key as a list of nodes. Null entries are kept as nil.
+func (n solcNode) nodes(key string) []solcNode {
+	toReturn := make([]solcNode, 0)
+	if n.has(key) {
+		_ = json.Unmarshal(n[key], &toReturn)
+	}
+	return toReturn
+}
+
+// documentation decodes solc `documentation` into NatSpec. Older compilers are emitting the
+// documentation as plain string while newer ones are emitting StructuredDocumentation node.
+func (n solcNode) documentation() *NatSpec {
+	if !n.has("documentation") {
+		return nil
+	}
+
+	if documentation := n.node("documentation"); documentation != nil {
+		return ParseNatSpec(documentation.str("text"))
+	}
+
+	return ParseNatSpec(n.str("documentation"))
+}
+
+// typeDescription decodes solc `typeDescriptions` into TypeDescription.
+func (n solcNode) typeDescription() *TypeDescription {
+	description := n.node("typeDescriptions")
+	if description == nil || (!description.has("typeIdentifier") && !description.has("typeString")) {
+		return nil
+	}
+
+	return &TypeDescription{
+		TypeIdentifier: description.str("typeIdentifier"),
+		TypeString:     description.str("typeString"),
+	}
+}
+
+// argumentTypes decodes solc `argumentTypes` into a list of TypeDescription.
+func (n solcNode) argumentTypes() []*TypeDescription {
+	toReturn := make([]*TypeDescription, 0)
+	for _, argument := range n.nodes("argumentTypes") {
+		if argument == nil {
+			continue
+		}
+		toReturn = append(toReturn, &TypeDescription{
+			TypeIdentifier: argument.str("typeIdentifier"),
+			TypeString:     argument.str("typeString"),
+		})
+	}
+	return toReturn
+}
+
+// solcVisibility converts solc visibility into solgo visibility.
+func solcVisibility(visibility string) ast_pb.Visibility {
+	switch visibility {
+	case "public":
+		return ast_pb.Visibility_PUBLIC
+	case "private":
+		return ast_pb.Visibility_PRIVATE
+	case "external":
+		return ast_pb.Visibility_EXTERNAL
+	case "internal":
+		return ast_pb.Visibility_INTERNAL
+	default:
+		return ast_pb.Visibility_V_DEFAULT
+	}
+}
+
+// solcMutability converts solc state mutability or variable mutability into solgo mutability.
+func solcMutability(mutability string) ast_pb.Mutability {
+	switch mutability {
+	case "pure":
+		return ast_pb.Mutability_PURE
+	case "view":
+		return ast_pb.Mutability_VIEW
+	case "payable":
+		return ast_pb.Mutability_PAYABLE
+	case "nonpayable":
+		return ast_pb.Mutability_NONPAYABLE
+	case "immutable":
+		return ast_pb.Mutability_IMMUTABLE
+	case "mutable", "constant":
+		return ast_pb.Mutability_MUTABLE
+	default:
+		return ast_pb.Mutability_M_DEFAULT
+	}
+}
+
+// solcStorageLocation converts solc storage location into solgo storage location.
+func solcStorageLocation(location string) ast_pb.StorageLocation {
+	switch location {
+	case "memory":
+		return ast_pb.StorageLocation_MEMORY
+	case "storage":
+		return ast_pb.StorageLocation_STORAGE
+	case "calldata":
+		return ast_pb.StorageLocation_CALLDATA
+	case "default":
+		return ast_pb.StorageLocation_DEFAULT
+	case "transient":
+		// Transient storage has no dedicated storage location, it is recorded on the state variable instead.
+		return ast_pb.StorageLocation_DEFAULT
+	default:
+		return ast_pb.StorageLocation_ST_UNKNOWN
+	}
+}
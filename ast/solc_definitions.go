@@ -0,0 +1,869 @@
+package ast
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	ast_pb "github.com/unpackdev/protos/dist/go/ast"
+)
+
+// pragma converts solc PragmaDirective into Pragma.
+func (i *solcImporter) pragma(node solcNode, parentId int64) Node[NodeType] {
+	text := i.text(node.str("src"))
+	if text == "" {
+		literals := node.strs("literals")
+		if len(literals) > 0 {
+			text = fmt.Sprintf("pragma %s %s;", literals[0], strings.Join(literals[1:], ""))
+		}
+	}
+
+	return &Pragma{
+		Id:       node.int("id"),
+		NodeType: ast_pb.NodeType_PRAGMA_DIRECTIVE,
+		Src:      i.src(node.str("src"), parentId),
+		Literals: getLiterals(text),
+		Text:     text,
+	}
+}
+
+// importDirective converts solc ImportDirective into Import.
+// Source unit reference is remapped to the solgo source unit once all of the files are converted.
+func (i *solcImporter) importDirective(node solcNode, parentId int64) Node[NodeType] {
+	id := node.int("id")
+
+	toReturn := &Import{
+		Id:           id,
+		NodeType:     ast_pb.NodeType_IMPORT_DIRECTIVE,
+		Src:          i.src(node.str("src"), parentId),
+		NameLocation: i.srcPtr(node.str("nameLocation"), id),
+		AbsolutePath: filepath.Base(filepath.Clean(node.str("absolutePath"))),
+		File:         filepath.Clean(node.str("file")),
+		Scope:        parentId,
+		UnitAlias:    node.str("unitAlias"),
+		UnitAliases:  make([]string, 0),
+		SourceUnit:   node.int("sourceUnit"),
+	}
+
+	for _, alias := range node.nodes("symbolAliases") {
+		if alias == nil {
+			continue
+		}
+
+		if name := alias.str("local"); name != "" {
+			toReturn.UnitAliases = append(toReturn.UnitAliases, name)
+		} else if foreign := alias.node("foreign"); foreign != nil {
+			toReturn.UnitAliases = append(toReturn.UnitAliases, foreign.str("name"))
+		}
+	}
+
+	return toReturn
+}
+
+// contract converts solc ContractDefinition into Contract, Library or Interface depending on the contract kind.
+func (i *solcImporter) contract(unit *SourceUnit[Node[ast_pb.SourceUnit]], node solcNode) (Node[NodeType], error) {
+	id := node.int("id")
+
+	baseContracts := make([]*BaseContract, 0)
+	for _, specifier := range node.nodes("baseContracts") {
+		baseContracts = append(baseContracts, i.baseContract(specifier, id))
+	}
+	unit.BaseContracts = baseContracts
+
+	nodes := make([]Node[NodeType], 0)
+	for _, child := range node.nodes("nodes") {
+		definition, err := i.definition(id, child)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, definition)
+	}
+
+	src := i.src(node.str("src"), unit.GetId())
+	nameLocation := i.src(node.str("nameLocation"), id)
+
+	switch node.str("contractKind") {
+	case "library":
+		return &Library{
+			ASTBuilder:              i.ASTBuilder,
+			Id:                      id,
+			Name:                    node.str("name"),
+			NodeType:                ast_pb.NodeType_CONTRACT_DEFINITION,
+			Src:                     src,
+			NameLocation:            nameLocation,
+			Abstract:                node.boolean("abstract"),
+			Kind:                    ast_pb.NodeType_KIND_LIBRARY,
+			FullyImplemented:        node.boolean("fullyImplemented"),
+			Nodes:                   nodes,
+			LinearizedBaseContracts: node.ints("linearizedBaseContracts"),
+			BaseContracts:           baseContracts,
+			ContractDependencies:    node.ints("contractDependencies"),
+			Documentation:           node.documentation(),
+		}, nil
+	case "interface":
+		return &Interface{
+			ASTBuilder:              i.ASTBuilder,
+			Id:                      id,
+			Name:                    node.str("name"),
+			NodeType:                ast_pb.NodeType_CONTRACT_DEFINITION,
+			Src:                     src,
+			NameLocation:            nameLocation,
+			Abstract:                node.boolean("abstract"),
+			Kind:                    ast_pb.NodeType_KIND_INTERFACE,
+			FullyImplemented:        node.boolean("fullyImplemented"),
+			Nodes:                   nodes,
+			LinearizedBaseContracts: node.ints("linearizedBaseContracts"),
+			BaseContracts:           baseContracts,
+			ContractDependencies:    node.ints("contractDependencies"),
+			Documentation:           node.documentation(),
+		}, nil
+	default:
+		return &Contract{
+			ASTBuilder:              i.ASTBuilder,
+			Id:                      id,
+			Name:                    node.str("name"),
+			NodeType:                ast_pb.NodeType_CONTRACT_DEFINITION,
+			Src:                     src,
+			NameLocation:            nameLocation,
+			Abstract:                node.boolean("abstract"),
+			Kind:                    ast_pb.NodeType_KIND_CONTRACT,
+			FullyImplemented:        node.boolean("fullyImplemented"),
+			Nodes:                   nodes,
+			LinearizedBaseContracts: node.ints("linearizedBaseContracts"),
+			BaseContracts:           baseContracts,
+			ContractDependencies:    node.ints("contractDependencies"),
+			Documentation:           node.documentation(),
+			StorageLayout:           i.storageLayout(node.node("storageLayout"), id),
+		}, nil
+	}
+}
+
+// storageLayout converts solc StorageLayoutSpecifier into StorageLayoutSpecifier.
+func (i *solcImporter) storageLayout(node solcNode, contractId int64) *StorageLayoutSpecifier {
+	if node == nil {
+		return nil
+	}
+
+	return &StorageLayoutSpecifier{
+		Src:                i.src(node.str("src"), contractId),
+		BaseSlotExpression: i.text(node.node("baseSlotExpression").str("src")),
+	}
+}
+
+// baseContract converts solc InheritanceSpecifier into BaseContract.
+// Constructor arguments of the inheritance specifier are not part of the solgo AST and are dropped.
+func (i *solcImporter) baseContract(node solcNode, contractId int64) *BaseContract {
+	baseName := node.node("baseName")
+
+	name := baseName.str("name")
+	if name == "" {
+		name = i.text(baseName.str("src"))
+	}
+
+	return &BaseContract{
+		Id:       node.int("id"),
+		NodeType: ast_pb.NodeType_INHERITANCE_SPECIFIER,
+		Src:      i.src(node.str("src"), contractId),
+		BaseName: &BaseContractName{
+			Id:                            baseName.int("id"),
+			NodeType:                      ast_pb.NodeType_IDENTIFIER_PATH,
+			Src:                           i.src(baseName.str("src"), contractId),
+			Name:                          name,
+			ContractReferencedDeclaration: baseName.int("referencedDeclaration"),
+		},
+	}
+}
+
+// definition converts solc contract body or file level definition into the solgo node.
+func (i *solcImporter) definition(parentId int64, node solcNode) (Node[NodeType], error) {
+	switch node.nodeType() {
+	case "FunctionDefinition":
+		return i.function(node, parentId)
+	case "ModifierDefinition":
+		return i.modifier(node, parentId)
+	case "VariableDeclaration":
+		return i.stateVariable(node, parentId)
+	case "EventDefinition":
+		return i.event(node, parentId)
+	case "ErrorDefinition":
+		return i.errorDefinition(node, parentId)
+	case "StructDefinition":
+		return i.structDefinition(node, parentId)
+	case "EnumDefinition":
+		return i.enum(node, parentId), nil
+	case "UserDefinedValueTypeDefinition":
+		return i.userDefinedValueType(node, parentId)
+	case "UsingForDirective":
+		return i.usingFor(node, parentId)
+	default:
+		return nil, fmt.Errorf("unsupported solc definition node type %q at %s", node.nodeType(), node.str("src"))
+	}
+}
+
+// function converts solc FunctionDefinition into Function, Constructor, Fallback or Receive depending on its kind.
+func (i *solcImporter) function(node solcNode, parentId int64) (Node[NodeType], error) {
+	id := node.int("id")
+	src := i.src(node.str("src"), parentId)
+
+	parameters, err := i.parameterList(node.node("parameters"), id)
+	if err != nil {
+		return nil, err
+	}
+
+	returnParameters, err := i.parameterList(node.node("returnParameters"), id)
+	if err != nil {
+		return nil, err
+	}
+	i.functionsByReturnParams[returnParameters.GetId()] = id
+
+	modifiers, err := i.modifierInvocations(node, id)
+	if err != nil {
+		return nil, err
+	}
+
+	overrides := i.overrides(node.node("overrides"), id)
+
+	body, err := i.functionBody(node.node("body"), src, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch node.str("kind") {
+	case "constructor":
+		return &Constructor{
+			ASTBuilder:       i.ASTBuilder,
+			Id:               id,
+			NodeType:         ast_pb.NodeType_FUNCTION_DEFINITION,
+			Src:              src,
+			Kind:             ast_pb.NodeType_CONSTRUCTOR,
+			StateMutability:  solcMutability(node.str("stateMutability")),
+			Visibility:       solcVisibility(node.str("visibility")),
+			Implemented:      node.boolean("implemented"),
+			Modifiers:        modifiers,
+			Parameters:       parameters,
+			ReturnParameters: returnParameters,
+			Scope:            node.int("scope"),
+			Body:             body,
+			Documentation:    node.documentation(),
+		}, nil
+	case "fallback":
+		return &Fallback{
+			ASTBuilder:       i.ASTBuilder,
+			Id:               id,
+			NodeType:         ast_pb.NodeType_FUNCTION_DEFINITION,
+			Kind:             ast_pb.NodeType_FALLBACK,
+			Src:              src,
+			Implemented:      node.boolean("implemented"),
+			Visibility:       solcVisibility(node.str("visibility")),
+			StateMutability:  solcMutability(node.str("stateMutability")),
+			Modifiers:        modifiers,
+			Overrides:        overrides,
+			Parameters:       parameters,
+			ReturnParameters: returnParameters,
+			Body:             body,
+			Virtual:          node.boolean("virtual"),
+		}, nil
+	case "receive":
+		return &Receive{
+			ASTBuilder:       i.ASTBuilder,
+			Id:               id,
+			NodeType:         ast_pb.NodeType_FUNCTION_DEFINITION,
+			Kind:             ast_pb.NodeType_RECEIVE,
+			Src:              src,
+			Implemented:      node.boolean("implemented"),
+			Visibility:       solcVisibility(node.str("visibility")),
+			StateMutability:  solcMutability(node.str("stateMutability")),
+			Modifiers:        modifiers,
+			Overrides:        overrides,
+			Parameters:       parameters,
+			ReturnParameters: returnParameters,
+			Body:             body,
+			Virtual:          node.boolean("virtual"),
+			Payable:          node.str("stateMutability") == "payable",
+		}, nil
+	}
+
+	toReturn := &Function{
+		ASTBuilder:       i.ASTBuilder,
+		Id:               id,
+		Name:             node.str("name"),
+		NodeType:         ast_pb.NodeType_FUNCTION_DEFINITION,
+		Kind:             ast_pb.NodeType_KIND_FUNCTION,
+		Src:              src,
+		NameLocation:     i.src(node.str("nameLocation"), id),
+		Body:             body,
+		Implemented:      node.boolean("implemented"),
+		Visibility:       solcVisibility(node.str("visibility")),
+		StateMutability:  solcMutability(node.str("stateMutability")),
+		Virtual:          node.boolean("virtual"),
+		Modifiers:        modifiers,
+		Overrides:        overrides,
+		Parameters:       parameters,
+		ReturnParameters: returnParameters,
+		Scope:            node.int("scope"),
+		Text:             i.text(node.str("src")),
+		Documentation:    node.documentation(),
+	}
+	toReturn.ComputeSignature()
+	toReturn.TypeDescription = toReturn.buildTypeDescription()
+
+	// Solc computes the selector from the canonical abi types, which is more accurate
+	// than the one computed from the type names so we are going to prefer it.
+	if selector := node.str("functionSelector"); selector != "" {
+		toReturn.Signature = selector
+	}
+
+	return toReturn, nil
+}
+
+// functionBody converts solc function or modifier body. Unimplemented functions get an empty body
+// spanning the whole definition, the same as when parsing sources.
+func (i *solcImporter) functionBody(node solcNode, src SrcNode, parentId int64) (*BodyNode, error) {
+	if node == nil {
+		body := NewBodyNode(i.ASTBuilder, false)
+		body.Src = src
+		body.Src.ParentIndex = parentId
+		return body, nil
+	}
+
+	return i.block(node, parentId, false)
+}
+
+// modifier converts solc ModifierDefinition into ModifierDefinition.
+func (i *solcImporter) modifier(node solcNode, parentId int64) (Node[NodeType], error) {
+	id := node.int("id")
+	src := i.src(node.str("src"), parentId)
+
+	parameters, err := i.parameterList(node.node("parameters"), id)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := i.functionBody(node.node("body"), src, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ModifierDefinition{
+		ASTBuilder:    i.ASTBuilder,
+		Id:            id,
+		Name:          node.str("name"),
+		NodeType:      ast_pb.NodeType_MODIFIER_DEFINITION,
+		Src:           src,
+		NameLocation:  i.src(node.str("nameLocation"), id),
+		Visibility:    solcVisibility(node.str("visibility")),
+		Virtual:       node.boolean("virtual"),
+		Parameters:    parameters,
+		Body:          body,
+		Documentation: node.documentation(),
+	}, nil
+}
+
+// modifierInvocations converts solc ModifierInvocation list of the function definition.
+func (i *solcImporter) modifierInvocations(node solcNode, functionId int64) ([]*ModifierInvocation, error) {
+	toReturn := make([]*ModifierInvocation, 0)
+
+	for _, invocation := range node.nodes("modifiers") {
+		id := invocation.int("id")
+		modifierName := invocation.node("modifierName")
+
+		name := modifierName.str("name")
+		if name == "" {
+			name = i.text(modifierName.str("src"))
+		}
+
+		modifier := &ModifierInvocation{
+			ASTBuilder:    i.ASTBuilder,
+			Id:            id,
+			Name:          name,
+			NodeType:      ast_pb.NodeType_MODIFIER_INVOCATION,
+			Kind:          ast_pb.NodeType_MODIFIER_INVOCATION,
+			Src:           i.src(invocation.str("src"), functionId),
+			ArgumentTypes: make([]*TypeDescription, 0),
+			Arguments:     make([]Node[NodeType], 0),
+			ModifierName: &ModifierName{
+				Id:       modifierName.int("id"),
+				Name:     name,
+				NodeType: ast_pb.NodeType_IDENTIFIER,
+				Src:      i.src(modifierName.str("src"), id),
+			},
+		}
+
+		for _, argument := range invocation.nodes("arguments") {
+			expr, err := i.expression(argument, id)
+			if err != nil {
+				return nil, err
+			}
+			modifier.Arguments = append(modifier.Arguments, expr)
+			modifier.ArgumentTypes = append(modifier.ArgumentTypes, expr.GetTypeDescription())
+		}
+
+		toReturn = append(toReturn, modifier)
+	}
+
+	return toReturn, nil
+}
+
+// overrides converts solc OverrideSpecifier. Solc has a single specifier per declaration
+// while solgo keeps a list of them.
+func (i *solcImporter) overrides(node solcNode, parentId int64) []*OverrideSpecifier {
+	toReturn := make([]*OverrideSpecifier, 0)
+	if node == nil {
+		return toReturn
+	}
+
+	id := node.int("id")
+	specifier := NewOverrideSpecifier(i.ASTBuilder)
+	specifier.Id = id
+	specifier.Src = i.src(node.str("src"), parentId)
+	specifier.Overrides = make([]*OverridePath, 0)
+
+	for _, path := range node.nodes("overrides") {
+		name := path.str("name")
+		if name == "" {
+			name = i.text(path.str("src"))
+		}
+
+		// Identifier paths do not carry type descriptions, and overrides can only reference contracts.
+		typeDescription := path.typeDescription()
+		if typeDescription == nil {
+			typeDescription = &TypeDescription{
+				TypeIdentifier: fmt.Sprintf("t_contract$_%s_$%d", name, path.int("referencedDeclaration")),
+				TypeString:     fmt.Sprintf("contract %s", name),
+			}
+		}
+
+		specifier.Overrides = append(specifier.Overrides, &OverridePath{
+			Id:                    path.int("id"),
+			Name:                  name,
+			NodeType:              ast_pb.NodeType_OVERRIDE_PATH,
+			Src:                   i.src(path.str("src"), id),
+			ReferencedDeclaration: path.int("referencedDeclaration"),
+			TypeDescription:       typeDescription,
+		})
+	}
+
+	return append(toReturn, specifier)
+}
+
+// stateVariable converts solc state VariableDeclaration into StateVariableDeclaration.
+func (i *solcImporter) stateVariable(node solcNode, parentId int64) (Node[NodeType], error) {
+	id := node.int("id")
+
+	typeName, err := i.typeName(node.node("typeName"), id)
+	if err != nil {
+		return nil, err
+	}
+
+	toReturn := &StateVariableDeclaration{
+		ASTBuilder:      i.ASTBuilder,
+		Id:              id,
+		Name:            node.str("name"),
+		Constant:        node.boolean("constant"),
+		StateVariable:   true,
+		NodeType:        ast_pb.NodeType_VARIABLE_DECLARATION,
+		Src:             i.src(node.str("src"), parentId),
+		Scope:           node.int("scope"),
+		TypeDescription: node.typeDescription(),
+		Visibility:      solcVisibility(node.str("visibility")),
+		StorageLocation: solcStorageLocation(node.str("storageLocation")),
+		StateMutability: solcMutability(node.str("mutability")),
+		Transient:       node.str("storageLocation") == "transient",
+		TypeName:        typeName,
+		Documentation:   node.documentation(),
+	}
+
+	if value := node.node("value"); value != nil {
+		if toReturn.InitialValue, err = i.expression(value, id); err != nil {
+			return nil, err
+		}
+	}
+
+	return toReturn, nil
+}
+
+// parameterList converts solc ParameterList into ParameterList.
+func (i *solcImporter) parameterList(node solcNode, parentId int64) (*ParameterList, error) {
+	toReturn := NewParameterList(i.ASTBuilder)
+	if node == nil {
+		return toReturn, nil
+	}
+
+	toReturn.Id = node.int("id")
+	toReturn.Src = i.src(node.str("src"), parentId)
+
+	for _, child := range node.nodes("parameters") {
+		parameter, err := i.parameter(child, toReturn.GetId())
+		if err != nil {
+			return nil, err
+		}
+		toReturn.Parameters = append(toReturn.Parameters, parameter)
+		toReturn.ParameterTypes = append(toReturn.ParameterTypes, parameter.GetTypeDescription())
+	}
+
+	return toReturn, nil
+}
+
+// parameter converts solc VariableDeclaration found in parameter lists and struct members into Parameter.
+func (i *solcImporter) parameter(node solcNode, parentId int64) (*Parameter, error) {
+	id := node.int("id")
+
+	typeName, err := i.typeName(node.node("typeName"), id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Parameter{
+		ASTBuilder:      i.ASTBuilder,
+		Id:              id,
+		NodeType:        ast_pb.NodeType_VARIABLE_DECLARATION,
+		Src:             i.src(node.str("src"), parentId),
+		NameLocation:    i.srcPtr(node.str("nameLocation"), id),
+		Scope:           node.int("scope"),
+		Name:            node.str("name"),
+		TypeName:        typeName,
+		StorageLocation: solcStorageLocation(node.str("storageLocation")),
+		Visibility:      solcVisibility(node.str("visibility")),
+		StateMutability: solcMutability(node.str("mutability")),
+		Constant:        node.boolean("constant"),
+		StateVariable:   node.boolean("stateVariable"),
+		TypeDescription: node.typeDescription(),
+		Indexed:         node.boolean("indexed"),
+	}, nil
+}
+
+// event converts solc EventDefinition into EventDefinition.
+func (i *solcImporter) event(node solcNode, parentId int64) (Node[NodeType], error) {
+	id := node.int("id")
+
+	parameters, err := i.parameterList(node.node("parameters"), id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventDefinition{
+		ASTBuilder:     i.ASTBuilder,
+		SourceUnitName: i.unitName,
+		Id:             id,
+		NodeType:       ast_pb.NodeType_EVENT_DEFINITION,
+		Src:            i.src(node.str("src"), parentId),
+		Parameters:     parameters,
+		Name:           node.str("name"),
+		Anonymous:      node.boolean("anonymous"),
+		TypeDescription: &TypeDescription{
+			TypeIdentifier: fmt.Sprintf("t_event&_%s_%s_&%d", i.unitName, node.str("name"), id),
+			TypeString:     fmt.Sprintf("event %s.%s", i.unitName, node.str("name")),
+		},
+		Documentation: node.documentation(),
+	}, nil
+}
+
+// errorDefinition converts solc ErrorDefinition into ErrorDefinition.
+func (i *solcImporter) errorDefinition(node solcNode, parentId int64) (Node[NodeType], error) {
+	id := node.int("id")
+
+	parameters, err := i.parameterList(node.node("parameters"), id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ErrorDefinition{
+		ASTBuilder:     i.ASTBuilder,
+		SourceUnitName: i.unitName,
+		Id:             id,
+		NodeType:       ast_pb.NodeType_ERROR_DEFINITION,
+		Src:            i.src(node.str("src"), parentId),
+		Name:           node.str("name"),
+		NameLocation:   i.src(node.str("nameLocation"), id),
+		Parameters:     parameters,
+		TypeDescription: &TypeDescription{
+			TypeIdentifier: fmt.Sprintf("t_error$_%s_%s_$%d", i.unitName, node.str("name"), id),
+			TypeString:     fmt.Sprintf("error %s.%s", i.unitName, node.str("name")),
+		},
+		Documentation: node.documentation(),
+	}, nil
+}
+
+// structDefinition converts solc StructDefinition into StructDefinition.
+func (i *solcImporter) structDefinition(node solcNode, parentId int64) (Node[NodeType], error) {
+	id := node.int("id")
+
+	canonicalName := node.str("canonicalName")
+	if canonicalName == "" {
+		canonicalName = fmt.Sprintf("%s.%s", i.unitName, node.str("name"))
+	}
+
+	toReturn := &StructDefinition{
+		ASTBuilder:      i.ASTBuilder,
+		SourceUnitName:  i.unitName,
+		Id:              id,
+		NodeType:        ast_pb.NodeType_STRUCT_DEFINITION,
+		Src:             i.src(node.str("src"), parentId),
+		Name:            node.str("name"),
+		NameLocation:    i.src(node.str("nameLocation"), id),
+		CanonicalName:   canonicalName,
+		Visibility:      solcVisibility(node.str("visibility")),
+		StorageLocation: ast_pb.StorageLocation_DEFAULT,
+		Members:         make([]Node[NodeType], 0),
+		Documentation:   node.documentation(),
+		TypeDescription: &TypeDescription{
+			TypeIdentifier: fmt.Sprintf("t_struct$_%s_%s_$%d", i.unitName, node.str("name"), id),
+			TypeString:     fmt.Sprintf("struct %s", canonicalName),
+		},
+	}
+
+	for _, member := range node.nodes("members") {
+		parameter, err := i.parameter(member, id)
+		if err != nil {
+			return nil, err
+		}
+		toReturn.Members = append(toReturn.Members, parameter)
+	}
+
+	return toReturn, nil
+}
+
+// enum converts solc EnumDefinition into EnumDefinition.
+func (i *solcImporter) enum(node solcNode, parentId int64) Node[NodeType] {
+	id := node.int("id")
+	name := node.str("name")
+
+	canonicalName := node.str("canonicalName")
+	if canonicalName == "" {
+		canonicalName = fmt.Sprintf("%s.%s", i.unitName, name)
+	}
+
+	toReturn := &EnumDefinition{
+		ASTBuilder:     i.ASTBuilder,
+		SourceUnitName: i.unitName,
+		Id:             id,
+		NodeType:       ast_pb.NodeType_ENUM_DEFINITION,
+		Src:            i.src(node.str("src"), parentId),
+		NameLocation:   i.src(node.str("nameLocation"), id),
+		Name:           name,
+		CanonicalName:  canonicalName,
+		Members:        make([]Node[NodeType], 0),
+		TypeDescription: &TypeDescription{
+			TypeIdentifier: fmt.Sprintf("t_enum_$_%s_$%d", name, id),
+			TypeString:     fmt.Sprintf("enum %s", canonicalName),
+		},
+	}
+
+	for _, member := range node.nodes("members") {
+		memberId := member.int("id")
+		toReturn.Members = append(toReturn.Members, &Parameter{
+			ASTBuilder:   i.ASTBuilder,
+			Id:           memberId,
+			Name:         member.str("name"),
+			NodeType:     ast_pb.NodeType_ENUM_VALUE,
+			Src:          i.src(member.str("src"), id),
+			NameLocation: i.srcPtr(member.str("nameLocation"), memberId),
+			TypeDescription: &TypeDescription{
+				TypeIdentifier: fmt.Sprintf("t_enum_$_%s$_%s_$%d", name, member.str("name"), memberId),
+				TypeString:     fmt.Sprintf("enum %s.%s", canonicalName, member.str("name")),
+			},
+		})
+	}
+
+	return toReturn
+}
+
+// userDefinedValueType converts solc UserDefinedValueTypeDefinition into UserDefinedValueTypeDefinition.
+func (i *solcImporter) userDefinedValueType(node solcNode, parentId int64) (Node[NodeType], error) {
+	id := node.int("id")
+
+	typeName, err := i.typeName(node.node("underlyingType"), id)
+	if err != nil {
+		return nil, err
+	}
+
+	toReturn := &UserDefinedValueTypeDefinition{
+		ASTBuilder:   i.ASTBuilder,
+		Id:           id,
+		NodeType:     ast_pb.NodeType_USER_DEFINED_VALUE_TYPE,
+		Src:          i.src(node.str("src"), parentId),
+		Is:           true,
+		Type:         "type",
+		Name:         node.str("name"),
+		NameLocation: i.src(node.str("nameLocation"), id),
+		TypeName:     typeName,
+	}
+
+	if typeName != nil {
+		toReturn.TypeDescription = typeName.GetTypeDescription()
+	}
+
+	return toReturn, nil
+}
+
+// usingFor converts solc UsingForDirective into UsingDirective.
+// Solgo supports a single library per directive, so `using {a, b} for T` keeps the first function only.
+func (i *solcImporter) usingFor(node solcNode, parentId int64) (Node[NodeType], error) {
+	id := node.int("id")
+
+	typeName, err := i.typeName(node.node("typeName"), id)
+	if err != nil {
+		return nil, err
+	}
+
+	toReturn := &UsingDirective{
+		ASTBuilder: i.ASTBuilder,
+		Id:         id,
+		NodeType:   ast_pb.NodeType_USING_FOR_DIRECTIVE,
+		Src:        i.src(node.str("src"), parentId),
+		TypeName:   typeName,
+		Global:     node.boolean("global"),
+	}
+
+	if typeName != nil {
+		toReturn.TypeDescription = typeName.GetTypeDescription()
+	}
+
+	for _, function := range node.nodes("functionList") {
+		if function == nil {
+			continue
+		}
+
+		// Functions bound to the operators are listed under the definition rather than the function key.
+		path := function.node("function")
+		if path == nil {
+			path = function.node("definition")
+		}
+		if path == nil {
+			continue
+		}
+
+		name := path.str("name")
+		if name == "" {
+			name = i.text(path.str("src"))
+		}
+
+		toReturn.Functions = append(toReturn.Functions, &UsingFunction{
+			Name:     name,
+			Operator: function.str("operator"),
+			Src:      i.src(path.str("src"), id),
+		})
+	}
+
+	libraryName := node.node("libraryName")
+	if libraryName == nil {
+		for _, function := range node.nodes("functionList") {
+			if function != nil && function.node("function") != nil {
+				libraryName = function.node("function")
+				break
+			}
+			if function != nil && function.node("definition") != nil {
+				libraryName = function.node("definition")
+				break
+			}
+		}
+	}
+
+	if libraryName != nil {
+		name := libraryName.str("name")
+		if name == "" {
+			name = i.text(libraryName.str("src"))
+		}
+
+		toReturn.LibraryName = &LibraryName{
+			ASTBuilder:            i.ASTBuilder,
+			Id:                    libraryName.int("id"),
+			NodeType:              ast_pb.NodeType_IDENTIFIER_PATH,
+			Src:                   i.src(libraryName.str("src"), id),
+			Name:                  name,
+			ReferencedDeclaration: libraryName.int("referencedDeclaration"),
+		}
+	}
+
+	return toReturn, nil
+}
+
+// typeName converts solc type name nodes into TypeName. It returns nil for absent type names,
+// such as `var` declarations or `using for *` directives.
+func (i *solcImporter) typeName(node solcNode, parentId int64) (*TypeName, error) {
+	if node == nil {
+		return nil, nil
+	}
+
+	toReturn := NewTypeName(i.ASTBuilder)
+	toReturn.Id = node.int("id")
+	toReturn.Src = i.src(node.str("src"), parentId)
+	toReturn.TypeDescription = node.typeDescription()
+
+	switch node.nodeType() {
+	case "ElementaryTypeName":
+		toReturn.NodeType = ast_pb.NodeType_ELEMENTARY_TYPE_NAME
+		toReturn.Name = node.str("name")
+		if toReturn.Name == "address" {
+			toReturn.StateMutability = ast_pb.Mutability_NONPAYABLE
+			if node.str("stateMutability") == "payable" {
+				toReturn.StateMutability = ast_pb.Mutability_PAYABLE
+			}
+		}
+	case "UserDefinedTypeName":
+		toReturn.NodeType = ast_pb.NodeType_USER_DEFINED_PATH_NAME
+		toReturn.Name = node.str("name")
+		toReturn.ReferencedDeclaration = node.int("referencedDeclaration")
+
+		if path := node.node("pathNode"); path != nil {
+			toReturn.Name = path.str("name")
+			toReturn.PathNode = &PathNode{
+				Id:                    path.int("id"),
+				Name:                  path.str("name"),
+				NodeType:              ast_pb.NodeType_IDENTIFIER_PATH,
+				ReferencedDeclaration: path.int("referencedDeclaration"),
+				Src:                   i.src(path.str("src"), toReturn.GetId()),
+				TypeDescription:       toReturn.TypeDescription,
+			}
+		}
+	case "Mapping":
+		keyType, err := i.typeName(node.node("keyType"), toReturn.GetId())
+		if err != nil {
+			return nil, err
+		}
+
+		valueType, err := i.typeName(node.node("valueType"), toReturn.GetId())
+		if err != nil {
+			return nil, err
+		}
+
+		toReturn.NodeType = ast_pb.NodeType_MAPPING_TYPE_NAME
+		toReturn.Name = fmt.Sprintf("mapping(%s=>%s)", keyType.GetName(), valueType.GetName())
+		toReturn.KeyType = keyType
+		toReturn.KeyNameLocation = i.srcPtr(node.str("keyNameLocation"), toReturn.GetId())
+		toReturn.ValueType = valueType
+		toReturn.ValueNameLocation = i.srcPtr(node.str("valueNameLocation"), toReturn.GetId())
+	case "ArrayTypeName":
+		baseType, err := i.typeName(node.node("baseType"), toReturn.GetId())
+		if err != nil {
+			return nil, err
+		}
+
+		// Same as when parsing, arrays are described by their full name and the length expression.
+		toReturn.NodeType = ast_pb.NodeType_IDENTIFIER
+		toReturn.ReferencedDeclaration = baseType.ReferencedDeclaration
+		toReturn.PathNode = baseType.PathNode
+
+		length := ""
+		if lengthNode := node.node("length"); lengthNode != nil {
+			if toReturn.Expression, err = i.expression(lengthNode, toReturn.GetId()); err != nil {
+				return nil, err
+			}
+
+			if length = i.text(lengthNode.str("src")); length == "" {
+				length = lengthNode.str("value")
+			}
+		}
+		toReturn.Name = fmt.Sprintf("%s[%s]", baseType.GetName(), length)
+	case "FunctionTypeName":
+		toReturn.NodeType = ast_pb.NodeType_FUNCTION_TYPE_NAME
+		toReturn.StateMutability = solcMutability(node.str("stateMutability"))
+		if toReturn.TypeDescription != nil {
+			toReturn.Name = toReturn.TypeDescription.TypeString
+		}
+	default:
+		return nil, fmt.Errorf("unsupported solc type name node type %q at %s", node.nodeType(), node.str("src"))
+	}
+
+	return toReturn, nil
+}
@@ -0,0 +1,540 @@
+package ast
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	ast_pb "github.com/unpackdev/protos/dist/go/ast"
+)
+
+// expressions converts a list of solc expressions. Missing components, such as holes
+// in tuple expressions, are skipped.
+func (i *solcImporter) expressions(nodes []solcNode, parentId int64) ([]Node[NodeType], error) {
+	toReturn := make([]Node[NodeType], 0)
+
+	for _, node := range nodes {
+		if node == nil {
+			continue
+		}
+
+		expr, err := i.expression(node, parentId)
+		if err != nil {
+			return nil, err
+		}
+		toReturn = append(toReturn, expr)
+	}
+
+	return toReturn, nil
+}
+
+// typeDescriptions returns type descriptions of the provided nodes.
+func (i *solcImporter) typeDescriptions(nodes ...Node[NodeType]) []*TypeDescription {
+	toReturn := make([]*TypeDescription, 0, len(nodes))
+	for _, node := range nodes {
+		if node != nil {
+			toReturn = append(toReturn, node.GetTypeDescription())
+		}
+	}
+	return toReturn
+}
+
+// expression converts solc expression nodes into solgo nodes.
+func (i *solcImporter) expression(node solcNode, parentId int64) (Node[NodeType], error) {
+	if node == nil {
+		return nil, fmt.Errorf("missing solc expression for node %d", parentId)
+	}
+
+	id := node.int("id")
+	src := i.src(node.str("src"), parentId)
+
+	switch node.nodeType() {
+	case "Identifier":
+		toReturn := NewPrimaryExpression(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+		toReturn.Name = node.str("name")
+		toReturn.ReferencedDeclaration = node.int("referencedDeclaration")
+		toReturn.OverloadedDeclarations = node.ints("overloadedDeclarations")
+		toReturn.TypeDescription = node.typeDescription()
+		toReturn.ArgumentTypes = node.argumentTypes()
+		toReturn.Text = toReturn.Name
+		return toReturn, nil
+	case "Literal":
+		return i.literal(node, parentId), nil
+	case "ElementaryTypeNameExpression":
+		toReturn := NewPrimaryExpression(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+		toReturn.TypeDescription = node.typeDescription()
+		toReturn.ArgumentTypes = node.argumentTypes()
+		toReturn.Pure = node.boolean("isPure")
+
+		// Solc prior to 0.6 describes the type name as plain string.
+		if typeNameNode := node.node("typeName"); typeNameNode != nil {
+			typeName, err := i.typeName(typeNameNode, id)
+			if err != nil {
+				return nil, err
+			}
+			toReturn.TypeName = typeName
+			toReturn.Name = typeName.GetName()
+		} else {
+			toReturn.Name = node.str("typeName")
+		}
+
+		toReturn.Text = toReturn.Name
+		return toReturn, nil
+	case "BinaryOperation":
+		return i.binaryOperation(node, parentId)
+	case "Assignment":
+		left, err := i.expression(node.node("leftHandSide"), id)
+		if err != nil {
+			return nil, err
+		}
+
+		right, err := i.expression(node.node("rightHandSide"), id)
+		if err != nil {
+			return nil, err
+		}
+
+		toReturn := NewAssignment(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+		toReturn.Operator = solcAssignmentOperator(node.str("operator"))
+		toReturn.LeftExpression = left
+		toReturn.RightExpression = right
+		toReturn.TypeDescription = node.typeDescription()
+		toReturn.Text = i.text(node.str("src"))
+		return toReturn, nil
+	case "UnaryOperation":
+		expression, err := i.expression(node.node("subExpression"), id)
+		if err != nil {
+			return nil, err
+		}
+
+		operator := solcUnaryOperator(node.str("operator"))
+		referencedDeclaration := node.int("referencedDeclaration")
+
+		if !node.boolean("prefix") {
+			toReturn := NewUnarySuffixExpression(i.ASTBuilder)
+			toReturn.Id = id
+			toReturn.Src = src
+			toReturn.Operator = operator
+			toReturn.Expression = expression
+			toReturn.ReferencedDeclaration = referencedDeclaration
+			toReturn.TypeDescription = node.typeDescription()
+			toReturn.Constant = node.boolean("isConstant")
+			toReturn.LValue = node.boolean("isLValue")
+			toReturn.Pure = node.boolean("isPure")
+			toReturn.LValueRequested = node.boolean("lValueRequested")
+			return toReturn, nil
+		}
+
+		toReturn := NewUnaryPrefixExpression(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+		toReturn.Operator = operator
+		toReturn.Prefix = true
+		toReturn.Expression = expression
+		toReturn.ReferencedDeclaration = referencedDeclaration
+		toReturn.TypeDescription = node.typeDescription()
+		toReturn.Constant = node.boolean("isConstant")
+		toReturn.LValue = node.boolean("isLValue")
+		toReturn.Pure = node.boolean("isPure")
+		toReturn.LValueRequested = node.boolean("lValueRequested")
+		return toReturn, nil
+	case "FunctionCall":
+		return i.functionCall(node, parentId)
+	case "FunctionCallOptions":
+		expression, err := i.expression(node.node("expression"), id)
+		if err != nil {
+			return nil, err
+		}
+
+		options, err := i.expressions(node.nodes("options"), id)
+		if err != nil {
+			return nil, err
+		}
+
+		toReturn := NewFunctionCallOption(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+		toReturn.Expression = expression
+		toReturn.Names = node.strs("names")
+		toReturn.Options = options
+		toReturn.TypeDescription = node.typeDescription()
+		return toReturn, nil
+	case "MemberAccess":
+		expression, err := i.expression(node.node("expression"), id)
+		if err != nil {
+			return nil, err
+		}
+
+		toReturn := NewMemberAccessExpression(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+		toReturn.MemberLocation = i.src(node.str("memberLocation"), id)
+		toReturn.Expression = expression
+		toReturn.MemberName = node.str("memberName")
+		toReturn.ArgumentTypes = node.argumentTypes()
+		toReturn.ReferencedDeclaration = node.int("referencedDeclaration")
+		toReturn.TypeDescription = node.typeDescription()
+		toReturn.Constant = node.boolean("isConstant")
+		toReturn.LValue = node.boolean("isLValue")
+		toReturn.Pure = node.boolean("isPure")
+		toReturn.LValueRequested = node.boolean("lValueRequested")
+		toReturn.Text = i.text(node.str("src"))
+		return toReturn, nil
+	case "IndexAccess":
+		base, err := i.expression(node.node("baseExpression"), id)
+		if err != nil {
+			return nil, err
+		}
+
+		toReturn := NewIndexAccess(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+		toReturn.BaseExpression = base
+		toReturn.ReferencedDeclaration = node.int("referencedDeclaration")
+		toReturn.TypeDescription = node.typeDescription()
+
+		// Index is omitted when the access describes an array type, e.g. `abi.decode(data, (uint256[]))`.
+		if index := node.node("indexExpression"); index != nil {
+			if toReturn.IndexExpression, err = i.expression(index, id); err != nil {
+				return nil, err
+			}
+		}
+
+		toReturn.TypeDescriptions = i.typeDescriptions(toReturn.BaseExpression, toReturn.IndexExpression)
+		return toReturn, nil
+	case "IndexRangeAccess":
+		toReturn := NewIndexRangeAccessExpression(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+
+		base, err := i.expression(node.node("baseExpression"), id)
+		if err != nil {
+			return nil, err
+		}
+		toReturn.BaseExpression = base
+
+		if start := node.node("startExpression"); start != nil {
+			expr, err := i.expression(start, id)
+			if err != nil {
+				return nil, err
+			}
+			toReturn.LeftExpression = expr
+		}
+
+		if end := node.node("endExpression"); end != nil {
+			expr, err := i.expression(end, id)
+			if err != nil {
+				return nil, err
+			}
+			toReturn.RightExpression = expr
+		}
+
+		toReturn.TypeDescriptions = i.typeDescriptions(toReturn.BaseExpression, toReturn.LeftExpression, toReturn.RightExpression)
+		return toReturn, nil
+	case "Conditional":
+		expressions, err := i.expressions(
+			[]solcNode{node.node("condition"), node.node("trueExpression"), node.node("falseExpression")}, id,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		toReturn := NewConditionalExpression(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+		toReturn.Expressions = expressions
+		toReturn.TypeDescriptions = i.typeDescriptions(expressions...)
+		toReturn.TypeDescription = node.typeDescription()
+		return toReturn, nil
+	case "TupleExpression":
+		components, err := i.expressions(node.nodes("components"), id)
+		if err != nil {
+			return nil, err
+		}
+
+		if node.boolean("isInlineArray") {
+			toReturn := NewInlineArrayExpression(i.ASTBuilder)
+			toReturn.Id = id
+			toReturn.Src = src
+			toReturn.Expressions = components
+			toReturn.TypeDescriptions = i.typeDescriptions(components...)
+			toReturn.TypeDescription = node.typeDescription()
+			toReturn.Empty = len(components) == 0
+			return toReturn, nil
+		}
+
+		toReturn := NewTupleExpression(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+		toReturn.Constant = node.boolean("isConstant")
+		toReturn.Pure = node.boolean("isPure")
+		toReturn.Components = components
+		toReturn.TypeDescription = node.typeDescription()
+		return toReturn, nil
+	case "NewExpression":
+		typeName, err := i.typeName(node.node("typeName"), id)
+		if err != nil {
+			return nil, err
+		}
+
+		toReturn := NewExprExpression(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+		toReturn.TypeName = typeName
+		toReturn.ArgumentTypes = node.argumentTypes()
+		toReturn.TypeDescription = node.typeDescription()
+		if typeName != nil {
+			toReturn.ReferencedDeclaration = typeName.ReferencedDeclaration
+		}
+		return toReturn, nil
+	default:
+		return nil, fmt.Errorf("unsupported solc expression node type %q at %s", node.nodeType(), node.str("src"))
+	}
+}
+
+// literal converts solc Literal into PrimaryExpression.
+func (i *solcImporter) literal(node solcNode, parentId int64) *PrimaryExpression {
+	toReturn := NewPrimaryExpression(i.ASTBuilder)
+	toReturn.Id = node.int("id")
+	toReturn.NodeType = ast_pb.NodeType_LITERAL
+	toReturn.Src = i.src(node.str("src"), parentId)
+	toReturn.Value = node.str("value")
+	toReturn.HexValue = node.str("hexValue")
+	toReturn.TypeDescription = node.typeDescription()
+	toReturn.Pure = true
+	toReturn.Text = i.text(node.str("src"))
+
+	switch node.str("kind") {
+	case "bool":
+		toReturn.Kind = ast_pb.NodeType_BOOLEAN
+	case "string":
+		toReturn.Kind = ast_pb.NodeType_STRING
+	case "hexString":
+		toReturn.Kind = ast_pb.NodeType_HEX_STRING
+	case "unicodeString":
+		toReturn.Kind = ast_pb.NodeType_UNICODE_STRING_LITERAL
+	default:
+		toReturn.Kind = ast_pb.NodeType_NUMBER
+		// Parser keeps units as part of the number literal value, e.g. `1ether`.
+		if subdenomination := node.str("subdenomination"); subdenomination != "" {
+			toReturn.Value += subdenomination
+		}
+	}
+
+	if toReturn.HexValue == "" {
+		toReturn.HexValue = hex.EncodeToString([]byte(toReturn.Value))
+	}
+
+	return toReturn
+}
+
+// binaryOperation converts solc BinaryOperation into the solgo node matching the operator,
+// the same way the parser splits binary operations into dedicated nodes.
+func (i *solcImporter) binaryOperation(node solcNode, parentId int64) (Node[NodeType], error) {
+	id := node.int("id")
+	src := i.src(node.str("src"), parentId)
+
+	left, err := i.expression(node.node("leftExpression"), id)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := i.expression(node.node("rightExpression"), id)
+	if err != nil {
+		return nil, err
+	}
+
+	typeDescriptions := i.typeDescriptions(left, right)
+
+	switch operator := node.str("operator"); operator {
+	case "&&":
+		toReturn := NewAndOperationExpression(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+		toReturn.Expressions = []Node[NodeType]{left, right}
+		toReturn.TypeDescriptions = typeDescriptions
+		return toReturn, nil
+	case "**":
+		toReturn := NewExprOperationExpression(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+		toReturn.LeftExpression = left
+		toReturn.RightExpression = right
+		toReturn.TypeDescriptions = typeDescriptions
+		return toReturn, nil
+	case "&":
+		toReturn := NewBitAndOperationExpression(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+		toReturn.Expressions = []Node[NodeType]{left, right}
+		toReturn.TypeDescriptions = typeDescriptions
+		return toReturn, nil
+	case "|":
+		toReturn := NewBitOrOperationExpression(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+		toReturn.Expressions = []Node[NodeType]{left, right}
+		toReturn.TypeDescriptions = typeDescriptions
+		return toReturn, nil
+	case "^":
+		toReturn := NewBitXorOperationExpression(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+		toReturn.Expressions = []Node[NodeType]{left, right}
+		toReturn.TypeDescriptions = typeDescriptions
+		toReturn.TypeDescription = node.typeDescription()
+		return toReturn, nil
+	case "<<", ">>":
+		toReturn := NewShiftOperationExpression(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+		toReturn.Operator = ast_pb.NodeType_SHIFT_LEFT_OPERATION
+		if operator == ">>" {
+			toReturn.Operator = ast_pb.NodeType_SHIFT_RIGHT_OPERATION
+		}
+		toReturn.Expressions = []Node[NodeType]{left, right}
+		toReturn.TypeDescriptions = typeDescriptions
+		toReturn.TypeDescription = node.typeDescription()
+		return toReturn, nil
+	default:
+		toReturn := NewBinaryOperationExpression(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+		toReturn.Operator = solcBinaryOperator(operator)
+		toReturn.Constant = node.boolean("isConstant")
+		toReturn.Pure = node.boolean("isPure")
+		toReturn.LeftExpression = left
+		toReturn.RightExpression = right
+		toReturn.TypeDescription = node.typeDescription()
+		return toReturn, nil
+	}
+}
+
+// functionCall converts solc FunctionCall into FunctionCall, or PayableConversion for `payable(x)`.
+func (i *solcImporter) functionCall(node solcNode, parentId int64) (Node[NodeType], error) {
+	id := node.int("id")
+	src := i.src(node.str("src"), parentId)
+
+	expression, err := i.expression(node.node("expression"), id)
+	if err != nil {
+		return nil, err
+	}
+
+	arguments, err := i.expressions(node.nodes("arguments"), id)
+	if err != nil {
+		return nil, err
+	}
+
+	callee := node.node("expression")
+	referencedDeclaration := callee.int("referencedDeclaration")
+
+	if callee.nodeType() == "ElementaryTypeNameExpression" {
+		if typeName := callee.node("typeName"); typeName != nil && typeName.str("stateMutability") == "payable" {
+			toReturn := NewPayableConversionExpression(i.ASTBuilder)
+			toReturn.Id = id
+			toReturn.Src = src
+			toReturn.Arguments = arguments
+			toReturn.ArgumentTypes = i.typeDescriptions(arguments...)
+			toReturn.TypeDescription = node.typeDescription()
+			toReturn.Payable = true
+			return toReturn, nil
+		}
+	}
+
+	toReturn := NewFunctionCall(i.ASTBuilder)
+	toReturn.Id = id
+	toReturn.Src = src
+	toReturn.Expression = expression
+	toReturn.Arguments = arguments
+	toReturn.Names = node.strs("names")
+	toReturn.ArgumentTypes = i.typeDescriptions(arguments...)
+	toReturn.ReferencedDeclaration = referencedDeclaration
+	toReturn.TypeDescription = node.typeDescription()
+	return toReturn, nil
+}
+
+// solcBinaryOperator converts solc binary operator into solgo operator.
+func solcBinaryOperator(operator string) ast_pb.Operator {
+	switch operator {
+	case "+":
+		return ast_pb.Operator_ADDITION
+	case "-":
+		return ast_pb.Operator_SUBTRACTION
+	case "*":
+		return ast_pb.Operator_MULTIPLICATION
+	case "/":
+		return ast_pb.Operator_DIVISION
+	case "%":
+		return ast_pb.Operator_MODULO
+	case "**":
+		return ast_pb.Operator_EXPONENTIATION
+	case ">":
+		return ast_pb.Operator_GREATER_THAN
+	case ">=":
+		return ast_pb.Operator_GREATER_THAN_OR_EQUAL
+	case "<":
+		return ast_pb.Operator_LESS_THAN
+	case "<=":
+		return ast_pb.Operator_LESS_THAN_OR_EQUAL
+	case "==":
+		return ast_pb.Operator_EQUAL
+	case "!=":
+		return ast_pb.Operator_NOT_EQUAL
+	case "||":
+		return ast_pb.Operator_OR
+	default:
+		return ast_pb.Operator_O_DEFAULT
+	}
+}
+
+// solcAssignmentOperator converts solc assignment operator into solgo operator.
+func solcAssignmentOperator(operator string) ast_pb.Operator {
+	switch operator {
+	case "=":
+		return ast_pb.Operator_EQUAL
+	case "+=":
+		return ast_pb.Operator_PLUS_EQUAL
+	case "-=":
+		return ast_pb.Operator_MINUS_EQUAL
+	case "*=":
+		return ast_pb.Operator_MUL_EQUAL
+	case "/=":
+		return ast_pb.Operator_DIV_EQUAL
+	case "%=":
+		return ast_pb.Operator_MOD_EQUAL
+	case "&=":
+		return ast_pb.Operator_AND_EQUAL
+	case "|=":
+		return ast_pb.Operator_OR_EQUAL
+	case "^=":
+		return ast_pb.Operator_XOR_EQUAL
+	case "<<=":
+		return ast_pb.Operator_SHIFT_LEFT_EQUAL
+	case ">>=":
+		return ast_pb.Operator_SHIFT_RIGHT_EQUAL
+	default:
+		return ast_pb.Operator_O_DEFAULT
+	}
+}
+
+// solcUnaryOperator converts solc unary operator into solgo operator.
+func solcUnaryOperator(operator string) ast_pb.Operator {
+	switch operator {
+	case "++":
+		return ast_pb.Operator_INCREMENT
+	case "--":
+		return ast_pb.Operator_DECREMENT
+	case "!":
+		return ast_pb.Operator_NOT
+	case "~":
+		return ast_pb.Operator_BIT_NOT
+	case "-":
+		return ast_pb.Operator_SUBTRACT
+	default:
+		return ast_pb.Operator_O_DEFAULT
+	}
+}
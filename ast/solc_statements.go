@@ -0,0 +1,358 @@
+package ast
+
+import (
+	"fmt"
+
+	ast_pb "github.com/unpackdev/protos/dist/go/ast"
+)
+
+// block converts solc Block or UncheckedBlock into BodyNode.
+// Typed blocks are used when the block is nested within another block as a statement.
+func (i *solcImporter) block(node solcNode, parentId int64, typed bool) (*BodyNode, error) {
+	id := node.int("id")
+
+	toReturn := NewBodyNode(i.ASTBuilder, typed)
+	toReturn.Id = id
+	toReturn.Src = i.src(node.str("src"), parentId)
+
+	if node.nodeType() == "UncheckedBlock" {
+		toReturn.NodeType = ast_pb.NodeType_UNCHECKED_BLOCK
+	}
+
+	for _, child := range node.nodes("statements") {
+		statement, err := i.statement(child, id)
+		if err != nil {
+			return nil, err
+		}
+		toReturn.Statements = append(toReturn.Statements, statement)
+	}
+
+	toReturn.Implemented = len(toReturn.Statements) > 0
+	return toReturn, nil
+}
+
+// statementBody converts body of the control flow statement. Solgo expects blocks for bodies,
+// so single statement bodies, such as `if (a) return;`, are wrapped into one.
+func (i *solcImporter) statementBody(node solcNode, parentId int64) (*BodyNode, error) {
+	if node == nil {
+		return NewBodyNode(i.ASTBuilder, false), nil
+	}
+
+	if node.nodeType() == "Block" {
+		return i.block(node, parentId, false)
+	}
+
+	toReturn := NewBodyNode(i.ASTBuilder, false)
+	toReturn.Src = i.src(node.str("src"), parentId)
+
+	statement, err := i.statement(node, toReturn.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	toReturn.Statements = append(toReturn.Statements, statement)
+	toReturn.Implemented = true
+	return toReturn, nil
+}
+
+// statement converts solc statement nodes into solgo nodes.
+func (i *solcImporter) statement(node solcNode, parentId int64) (Node[NodeType], error) {
+	id := node.int("id")
+	src := i.src(node.str("src"), parentId)
+
+	switch node.nodeType() {
+	case "Block", "UncheckedBlock":
+		body, err := i.block(node, parentId, node.nodeType() == "Block")
+		if err != nil {
+			return nil, err
+		}
+		return body, nil
+	case "ExpressionStatement":
+		return i.expression(node.node("expression"), parentId)
+	case "VariableDeclarationStatement":
+		return i.variableDeclaration(node, parentId)
+	case "PlaceholderStatement":
+		toReturn := NewPrimaryExpression(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.NodeType = ast_pb.NodeType_PLACEHOLDER_STATEMENT
+		toReturn.Src = src
+		toReturn.Name = "_"
+		return toReturn, nil
+	case "Return":
+		toReturn := NewReturnStatement(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+		toReturn.FunctionReturnParameters = i.functionsByReturnParams[node.int("functionReturnParameters")]
+
+		if expression := node.node("expression"); expression != nil {
+			expr, err := i.expression(expression, id)
+			if err != nil {
+				return nil, err
+			}
+			toReturn.Expression = expr
+		}
+
+		return toReturn, nil
+	case "IfStatement":
+		toReturn := NewIfStatement(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+
+		condition, err := i.expression(node.node("condition"), id)
+		if err != nil {
+			return nil, err
+		}
+		toReturn.Condition = condition
+
+		body, err := i.statementBody(node.node("trueBody"), id)
+		if err != nil {
+			return nil, err
+		}
+		toReturn.Body = body
+
+		if falseBody := node.node("falseBody"); falseBody != nil {
+			elseBody, err := i.statementBody(falseBody, id)
+			if err != nil {
+				return nil, err
+			}
+			toReturn.Else = elseBody
+		}
+
+		return toReturn, nil
+	case "ForStatement":
+		toReturn := NewForStatement(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+
+		if initialiser := node.node("initializationExpression"); initialiser != nil {
+			statement, err := i.statement(initialiser, id)
+			if err != nil {
+				return nil, err
+			}
+			toReturn.Initialiser = statement
+		}
+
+		if condition := node.node("condition"); condition != nil {
+			expr, err := i.expression(condition, id)
+			if err != nil {
+				return nil, err
+			}
+			toReturn.Condition = expr
+		}
+
+		if closure := node.node("loopExpression"); closure != nil {
+			statement, err := i.statement(closure, id)
+			if err != nil {
+				return nil, err
+			}
+			toReturn.Closure = statement
+		}
+
+		body, err := i.statementBody(node.node("body"), id)
+		if err != nil {
+			return nil, err
+		}
+		toReturn.Body = body
+
+		return toReturn, nil
+	case "WhileStatement":
+		toReturn := NewWhileStatement(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+
+		condition, err := i.expression(node.node("condition"), id)
+		if err != nil {
+			return nil, err
+		}
+		toReturn.Condition = condition
+
+		body, err := i.statementBody(node.node("body"), id)
+		if err != nil {
+			return nil, err
+		}
+		toReturn.Body = body
+
+		return toReturn, nil
+	case "DoWhileStatement":
+		toReturn := NewDoWhileStatement(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+
+		condition, err := i.expression(node.node("condition"), id)
+		if err != nil {
+			return nil, err
+		}
+		toReturn.Condition = condition
+
+		body, err := i.statementBody(node.node("body"), id)
+		if err != nil {
+			return nil, err
+		}
+		toReturn.Body = body
+
+		return toReturn, nil
+	case "Break":
+		toReturn := NewBreakStatement(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+		return toReturn, nil
+	case "Continue":
+		toReturn := NewContinueStatement(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+		return toReturn, nil
+	case "EmitStatement":
+		expression, arguments, err := i.callStatement(node.node("eventCall"), id)
+		if err != nil {
+			return nil, err
+		}
+
+		toReturn := NewEmitStatement(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+		toReturn.Expression = expression
+		toReturn.Arguments = arguments
+		return toReturn, nil
+	case "RevertStatement":
+		expression, arguments, err := i.callStatement(node.node("errorCall"), id)
+		if err != nil {
+			return nil, err
+		}
+
+		toReturn := NewRevertStatement(i.ASTBuilder)
+		toReturn.Id = id
+		toReturn.Src = src
+		toReturn.Expression = expression
+		toReturn.Arguments = arguments
+		return toReturn, nil
+	case "TryStatement":
+		return i.tryStatement(node, parentId)
+	case "InlineAssembly":
+		return i.inlineAssembly(node, parentId)
+	default:
+		return nil, fmt.Errorf("unsupported solc statement node type %q at %s", node.nodeType(), node.str("src"))
+	}
+}
+
+// variableDeclaration converts solc VariableDeclarationStatement into VariableDeclaration.
+func (i *solcImporter) variableDeclaration(node solcNode, parentId int64) (Node[NodeType], error) {
+	id := node.int("id")
+
+	toReturn := NewVariableDeclarationStatement(i.ASTBuilder)
+	toReturn.Id = id
+	toReturn.Src = i.src(node.str("src"), parentId)
+	toReturn.Assignments = make([]int64, 0)
+
+	for _, declaration := range node.nodes("declarations") {
+		// Tuple destructuring may skip components, e.g. `(, uint256 b) = f();`.
+		if declaration == nil {
+			toReturn.Assignments = append(toReturn.Assignments, 0)
+			continue
+		}
+
+		declarationId := declaration.int("id")
+		toReturn.Assignments = append(toReturn.Assignments, declarationId)
+		typeName, err := i.typeName(declaration.node("typeName"), declarationId)
+		if err != nil {
+			return nil, err
+		}
+
+		toReturn.Declarations = append(toReturn.Declarations, &Declaration{
+			ASTBuilder:      i.ASTBuilder,
+			Id:              declarationId,
+			StateMutability: solcMutability(declaration.str("mutability")),
+			Name:            declaration.str("name"),
+			NodeType:        ast_pb.NodeType_VARIABLE_DECLARATION,
+			Scope:           declaration.int("scope"),
+			Src:             i.src(declaration.str("src"), id),
+			NameLocation:    i.src(declaration.str("nameLocation"), declarationId),
+			IsStateVariable: declaration.boolean("stateVariable"),
+			StorageLocation: solcStorageLocation(declaration.str("storageLocation")),
+			TypeName:        typeName,
+			Visibility:      solcVisibility(declaration.str("visibility")),
+		})
+	}
+
+	if initialValue := node.node("initialValue"); initialValue != nil {
+		expr, err := i.expression(initialValue, id)
+		if err != nil {
+			return nil, err
+		}
+		toReturn.InitialValue = expr
+	}
+
+	return toReturn, nil
+}
+
+// callStatement splits solc FunctionCall of emit and revert statements into the callee expression and arguments.
+func (i *solcImporter) callStatement(node solcNode, parentId int64) (Node[NodeType], []Node[NodeType], error) {
+	if node == nil {
+		return nil, nil, fmt.Errorf("missing solc call expression for statement %d", parentId)
+	}
+
+	expression, err := i.expression(node.node("expression"), parentId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	arguments, err := i.expressions(node.nodes("arguments"), parentId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return expression, arguments, nil
+}
+
+// tryStatement converts solc TryStatement into TryStatement. The first solc clause is the success
+// clause holding the try body and return parameters, the rest are catch clauses.
+func (i *solcImporter) tryStatement(node solcNode, parentId int64) (Node[NodeType], error) {
+	id := node.int("id")
+
+	toReturn := NewTryStatement(i.ASTBuilder)
+	toReturn.Id = id
+	toReturn.Src = i.src(node.str("src"), parentId)
+
+	expression, err := i.expression(node.node("externalCall"), id)
+	if err != nil {
+		return nil, err
+	}
+	toReturn.Expression = expression
+
+	for index, clause := range node.nodes("clauses") {
+		clauseId := clause.int("id")
+
+		parameters, err := i.parameterList(clause.node("parameters"), clauseId)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := i.statementBody(clause.node("block"), clauseId)
+		if err != nil {
+			return nil, err
+		}
+
+		if index == 0 {
+			toReturn.Body = body
+			toReturn.Implemented = len(body.GetStatements()) > 0
+			toReturn.Returns = clause.node("parameters") != nil
+			toReturn.ReturnParameters = parameters
+			continue
+		}
+
+		catch := NewCatchClauseStatement(i.ASTBuilder)
+		catch.Id = clauseId
+		catch.Name = clause.str("errorName")
+		catch.Src = i.src(clause.str("src"), id)
+		catch.Parameters = parameters
+		catch.Body = body
+		toReturn.Clauses = append(toReturn.Clauses, catch)
+	}
+
+	if toReturn.ReturnParameters == nil {
+		toReturn.ReturnParameters = NewParameterList(i.ASTBuilder)
+		toReturn.ReturnParameters.Src = toReturn.Src
+		toReturn.ReturnParameters.Src.ParentIndex = id
+	}
+
+	return toReturn, nil
+}
@@ -0,0 +1,329 @@
+package ast
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	ast_pb "github.com/unpackdev/protos/dist/go/ast"
+	"github.com/unpackdev/solgo"
+	"github.com/unpackdev/solgo/tests"
+	"github.com/unpackdev/solgo/utils"
+)
+
+// readSolcJSONForTest reads solc compact ast files and joins them the same way
+// as `solc --ast-compact-json` does when multiple sources are compiled together.
+func readSolcJSONForTest(t *testing.T, names ...string) []byte {
+	toReturn := make([]byte, 0)
+	for _, name := range names {
+		jsonFile := tests.ReadJsonBytesForTest(t, "ast/"+name+".solc.ast")
+		toReturn = append(toReturn, []byte("\n======= "+name+".sol =======\n")...)
+		toReturn = append(toReturn, jsonFile.Bytes...)
+	}
+	return toReturn
+}
+
+// readSolcSourcesForTest reads contract sources matching the solc compact ast files.
+func readSolcSourcesForTest(t *testing.T, entry string, names ...string) *solgo.Sources {
+	sources := &solgo.Sources{EntrySourceUnitName: entry}
+	for _, name := range names {
+		contract := tests.ReadContractFileForTest(t, "ast/"+name)
+		sources.SourceUnits = append(sources.SourceUnits, &solgo.SourceUnit{
+			Name:    name,
+			Path:    name + ".sol",
+			Content: contract.Content,
+		})
+	}
+	return sources
+}
+
+func TestAstBuilderImportFromSolcJSON(t *testing.T) {
+	testCases := []struct {
+		name          string
+		files         []string
+		withSources   bool
+		entry         string
+		expectedUnits []string
+	}{
+		{
+			name:          "Simple Storage With Library",
+			files:         []string{"MathLib", "SimpleStorage"},
+			withSources:   true,
+			entry:         "SimpleStorage",
+			expectedUnits: []string{"MathLib", "SimpleStorage"},
+		},
+		{
+			name:          "ERC20 With Imports And Inheritance",
+			files:         []string{"ERC20"},
+			withSources:   true,
+			entry:         "ERC20",
+			expectedUnits: []string{"ERC20"},
+		},
+		{
+			name:          "Lottery Compact Json Cli Output",
+			files:         []string{"Lottery"},
+			withSources:   true,
+			entry:         "Lottery",
+			expectedUnits: []string{"IDummyContract", "Lottery"},
+		},
+		{
+			name:          "Safe Math Library",
+			files:         []string{"SafeMath"},
+			withSources:   true,
+			entry:         "SafeMath",
+			expectedUnits: []string{"SafeMath"},
+		},
+		{
+			name:          "Token Sale Without Sources",
+			expectedUnits: []string{"IERC20", "SafeMath", "TokenSale"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var sources *solgo.Sources
+			if testCase.withSources {
+				sources = readSolcSourcesForTest(t, testCase.entry, testCase.files...)
+			}
+
+			var jsonBytes []byte
+			if len(testCase.files) > 0 {
+				jsonBytes = readSolcJSONForTest(t, testCase.files...)
+			} else {
+				jsonBytes = tests.ReadJsonBytesForTest(t, "ast/TokenSale.solc.ast").Bytes
+			}
+
+			builder := NewAstBuilder(nil, sources)
+			root, err := builder.ImportFromSolcJSON(context.TODO(), jsonBytes)
+			assert.NoError(t, err)
+			assert.NotNil(t, root)
+			assert.Equal(t, root, builder.GetRoot())
+
+			names := make([]string, 0)
+			for _, unit := range root.GetSourceUnits() {
+				names = append(names, unit.GetName())
+				assert.NotNil(t, unit.GetContract())
+				assert.Equal(t, unit.GetId(), unit.GetContract().GetSrc().ParentIndex)
+				if testCase.withSources {
+					assert.Greater(t, unit.GetContract().GetSrc().Line, int64(0))
+				}
+			}
+			assert.ElementsMatch(t, testCase.expectedUnits, names)
+
+			if testCase.entry != "" {
+				assert.Equal(t, testCase.entry, root.GetSourceUnitById(root.GetEntrySourceUnit()).GetName())
+			}
+
+			assert.NotNil(t, root.ToProto())
+
+			prettyJson, err := utils.ToJSONPretty(root)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, prettyJson)
+		})
+	}
+}
+
+func TestAstBuilderImportFromSolcJSONReferences(t *testing.T) {
+	builder := NewAstBuilder(nil, readSolcSourcesForTest(t, "SimpleStorage", "MathLib", "SimpleStorage"))
+
+	root, err := builder.ImportFromSolcJSON(context.TODO(), readSolcJSONForTest(t, "MathLib", "SimpleStorage"))
+	assert.NoError(t, err)
+
+	library := root.GetSourceUnitByName("MathLib")
+	assert.NotNil(t, library)
+	assert.Equal(t, ast_pb.NodeType_KIND_LIBRARY, library.GetContract().(*Library).Kind)
+
+	unit := root.GetSourceUnitByName("SimpleStorage")
+	assert.NotNil(t, unit)
+	assert.Len(t, unit.GetPragmas(), 1)
+	assert.Equal(t, "pragma solidity ^0.8.0;", unit.GetPragmas()[0].GetText())
+	assert.Len(t, unit.GetImports(), 1)
+	assert.Equal(t, library.GetId(), unit.GetImports()[0].GetSourceUnit())
+
+	contract, ok := unit.GetContract().(*Contract)
+	assert.True(t, ok)
+	assert.Equal(t, int64(7), contract.GetSrc().Line)
+	assert.Equal(t, int64(0), contract.GetSrc().Column)
+
+	using, ok := contract.GetNodes()[0].(*UsingDirective)
+	assert.True(t, ok)
+	assert.Equal(t, "MathLib", using.GetLibraryName().Name)
+	assert.Equal(t, library.GetContract().GetId(), using.GetLibraryName().ReferencedDeclaration)
+
+	stateVariable, ok := contract.GetNodes()[1].(*StateVariableDeclaration)
+	assert.True(t, ok)
+	assert.Equal(t, "storedData", stateVariable.GetName())
+	assert.Equal(t, "t_uint256", stateVariable.GetTypeDescription().GetIdentifier())
+
+	var get *Function
+	for _, node := range contract.GetNodes() {
+		if function, ok := node.(*Function); ok && function.GetName() == "get" {
+			get = function
+		}
+	}
+
+	assert.NotNil(t, get)
+	assert.Equal(t, "6d4ce63c", get.GetSignature())
+	assert.Equal(t, ast_pb.Mutability_VIEW, get.GetStateMutability())
+	assert.Len(t, get.GetBody().GetStatements(), 1)
+
+	ret, ok := get.GetBody().GetStatements()[0].(*ReturnStatement)
+	assert.True(t, ok)
+	assert.Equal(t, get.GetId(), ret.FunctionReturnParameters)
+
+	identifier, ok := ret.GetExpression().(*PrimaryExpression)
+	assert.True(t, ok)
+	assert.Equal(t, stateVariable.GetId(), identifier.GetReferencedDeclaration())
+}
+
+func TestAstBuilderImportFromSolcJSONInheritance(t *testing.T) {
+	builder := NewAstBuilder(nil, readSolcSourcesForTest(t, "ERC20", "ERC20"))
+
+	root, err := builder.ImportFromSolcJSON(context.TODO(), readSolcJSONForTest(t, "ERC20"))
+	assert.NoError(t, err)
+
+	unit := root.GetSourceUnitByName("ERC20")
+	assert.NotNil(t, unit)
+	assert.Len(t, unit.GetImports(), 3)
+	assert.Len(t, unit.GetBaseContracts(), 3)
+
+	for _, baseContract := range unit.GetBaseContracts() {
+		assert.NotEmpty(t, baseContract.BaseName.Name)
+		assert.NotZero(t, baseContract.BaseName.ContractReferencedDeclaration)
+	}
+
+	contract := unit.GetContract().(*Contract)
+	assert.Equal(t, int64(38), contract.GetSrc().Line)
+
+	var transfer *Function
+	for _, node := range contract.GetNodes() {
+		if function, ok := node.(*Function); ok && function.GetName() == "transfer" {
+			transfer = function
+		}
+	}
+
+	assert.NotNil(t, transfer)
+	assert.Equal(t, "a9059cbb", transfer.GetSignature())
+	assert.Len(t, transfer.GetOverrides(), 1)
+	assert.Len(t, transfer.GetParameters().GetParameters(), 2)
+}
+
+func TestAstBuilderImportFromSolcJSONErrors(t *testing.T) {
+	builder := NewAstBuilder(nil, nil)
+
+	_, err := builder.ImportFromSolcJSON(context.TODO(), []byte(`{"foo": "bar"}`))
+	assert.Error(t, err)
+
+	_, err = builder.ImportFromSolcJSON(context.TODO(), []byte(`not a json`))
+	assert.Error(t, err)
+}
+
+func TestAstBuilderImportFromSolcJSONStatements(t *testing.T) {
+	builder := NewAstBuilder(nil, readSolcSourcesForTest(t, "Dispatcher", "Dispatcher"))
+
+	root, err := builder.ImportFromSolcJSON(context.TODO(), readSolcJSONForTest(t, "Dispatcher"))
+	assert.NoError(t, err)
+
+	contract := root.GetSourceUnitByName("Dispatcher").GetContract().(*Contract)
+	forward, ok := contract.GetNodes()[0].(*Function)
+	assert.True(t, ok)
+
+	statements := forward.GetBody().GetStatements()
+	assert.Len(t, statements, 4)
+
+	// Both branches of the if statement are imported.
+	ifStatement, ok := statements[0].(*IfStatement)
+	assert.True(t, ok)
+	assert.Len(t, ifStatement.GetBody().(*BodyNode).GetStatements(), 1)
+	elseBody, ok := ifStatement.GetElse().(*BodyNode)
+	assert.True(t, ok)
+	assert.Len(t, elseBody.GetStatements(), 1)
+	assert.Contains(t, ifStatement.GetNodes(), ifStatement.GetElse())
+
+	// Slice keeps the sliced expression and the omitted end of the range.
+	declaration, ok := statements[1].(*VariableDeclaration)
+	assert.True(t, ok)
+	assert.Equal(t, []int64{25}, declaration.GetAssignments())
+	indexRange, ok := declaration.GetInitialValue().(*IndexRange)
+	assert.True(t, ok)
+	assert.Equal(t, "data", indexRange.GetBaseExpression().(*PrimaryExpression).GetName())
+	assert.NotNil(t, indexRange.GetLeftExpression())
+	assert.Nil(t, indexRange.GetRightExpression())
+	assert.Len(t, indexRange.GetNodes(), 2)
+
+	// Named arguments and call options keep their names and values.
+	call, ok := statements[2].(*FunctionCall)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"data", "target"}, call.GetNames())
+	assert.Len(t, call.GetArguments(), 2)
+	options, ok := call.GetExpression().(*FunctionCallOption)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"value", "gas"}, options.GetNames())
+	assert.Len(t, options.GetOptions(), 2)
+	assert.Equal(t, "5000", options.GetOptions()[1].(*PrimaryExpression).GetValue())
+
+	// Switch keeps the matched expression, the default case has no case value.
+	assembly, ok := statements[3].(*Yul)
+	assert.True(t, ok)
+	yulStatement := assembly.GetBody().GetStatements()[0].(*YulStatement)
+	yulSwitch, ok := yulStatement.GetStatements()[0].(*YulSwitchStatement)
+	assert.True(t, ok)
+	assert.Equal(t, "result", yulSwitch.GetExpression().(*YulIdentifier).GetName())
+	assert.Len(t, yulSwitch.GetCases(), 2)
+	assert.NotNil(t, yulSwitch.GetCases()[0].(*YulSwitchCaseStatement).GetCase())
+	assert.Nil(t, yulSwitch.GetCases()[1].(*YulSwitchCaseStatement).GetCase())
+	assert.Len(t, yulSwitch.GetCases()[1].GetNodes(), 1)
+}
+
+func TestAstBuilderParseMatchesSolcJSON(t *testing.T) {
+	sources := readSolcSourcesForTest(t, "Dispatcher", "Dispatcher")
+
+	parser, err := solgo.NewParserFromSources(context.TODO(), sources)
+	assert.NoError(t, err)
+
+	builder := NewAstBuilder(parser.GetParser(), parser.GetSources())
+	assert.NoError(t, parser.RegisterListener(solgo.ListenerAst, builder))
+	assert.Empty(t, parser.Parse())
+
+	solcBuilder := NewAstBuilder(nil, sources)
+	solcRoot, err := solcBuilder.ImportFromSolcJSON(context.TODO(), readSolcJSONForTest(t, "Dispatcher"))
+	assert.NoError(t, err)
+
+	statementsOf := func(root *RootNode) []Node[NodeType] {
+		contract := root.GetSourceUnitByName("Dispatcher").GetContract().(*Contract)
+		for _, node := range contract.GetNodes() {
+			if function, ok := node.(*Function); ok {
+				return function.GetBody().GetStatements()
+			}
+		}
+		return nil
+	}
+
+	parsed, imported := statementsOf(builder.GetRoot()), statementsOf(solcRoot)
+	assert.Len(t, parsed, len(imported))
+
+	parsedIf, importedIf := parsed[0].(*IfStatement), imported[0].(*IfStatement)
+	assert.NotNil(t, parsedIf.GetElse())
+	assert.Len(t, parsedIf.GetElse().(*BodyNode).GetStatements(), len(importedIf.GetElse().(*BodyNode).GetStatements()))
+
+	parsedDeclaration, importedDeclaration := parsed[1].(*VariableDeclaration), imported[1].(*VariableDeclaration)
+	assert.Len(t, parsedDeclaration.GetAssignments(), len(importedDeclaration.GetAssignments()))
+	parsedRange := parsedDeclaration.GetInitialValue().(*IndexRange)
+	assert.Equal(t, "data", parsedRange.GetBaseExpression().(*PrimaryExpression).GetName())
+	assert.NotNil(t, parsedRange.GetLeftExpression())
+	assert.Nil(t, parsedRange.GetRightExpression())
+
+	parsedCall, importedCall := parsed[2].(*FunctionCall), imported[2].(*FunctionCall)
+	assert.Equal(t, importedCall.GetNames(), parsedCall.GetNames())
+	parsedOptions, importedOptions := parsedCall.GetExpression().(*FunctionCallOption), importedCall.GetExpression().(*FunctionCallOption)
+	assert.Equal(t, importedOptions.GetNames(), parsedOptions.GetNames())
+	assert.Len(t, parsedOptions.GetOptions(), len(importedOptions.GetOptions()))
+
+	yulSwitchOf := func(node Node[NodeType]) *YulSwitchStatement {
+		return node.(*Yul).GetBody().GetStatements()[0].(*YulStatement).GetStatements()[0].(*YulSwitchStatement)
+	}
+	parsedSwitch, importedSwitch := yulSwitchOf(parsed[3]), yulSwitchOf(imported[3])
+	assert.Equal(t, "result", parsedSwitch.GetExpression().(*YulIdentifier).GetName())
+	assert.Len(t, parsedSwitch.GetCases(), len(importedSwitch.GetCases()))
+	assert.Nil(t, parsedSwitch.GetCases()[1].(*YulSwitchCaseStatement).GetCase())
+}
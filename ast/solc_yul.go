@@ -0,0 +1,288 @@
+package ast
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	ast_pb "github.com/unpackdev/protos/dist/go/ast"
+)
+
+// inlineAssembly converts solc InlineAssembly into Yul. Solc yul nodes do not carry ids,
+// so new ones are assigned from the builder.
+func (i *solcImporter) inlineAssembly(node solcNode, parentId int64) (Node[NodeType], error) {
+	toReturn := NewYul(i.ASTBuilder)
+	toReturn.Id = node.int("id")
+	toReturn.Src = i.src(node.str("src"), parentId)
+
+	toReturn.Body = NewBodyNode(i.ASTBuilder, false)
+	toReturn.Body.Src = toReturn.Src
+	toReturn.Body.Src.ParentIndex = toReturn.Id
+	toReturn.Body.NodeType = ast_pb.NodeType_YUL_BLOCK
+	toReturn.Body.Statements = make([]Node[NodeType], 0)
+
+	if block := node.node("AST"); block != nil {
+		statements, err := i.yulStatements(block.nodes("statements"), toReturn.Id)
+		if err != nil {
+			return nil, err
+		}
+		toReturn.Body.Statements = statements
+		toReturn.Body.Implemented = len(statements) > 0
+	}
+
+	return toReturn, nil
+}
+
+// yulStatements converts solc yul statements, wrapping each of them into YulStatement.
+func (i *solcImporter) yulStatements(nodes []solcNode, parentId int64) ([]Node[NodeType], error) {
+	toReturn := make([]Node[NodeType], 0)
+
+	for _, node := range nodes {
+		wrapper := NewYulStatement(i.ASTBuilder)
+		wrapper.Src = i.src(node.str("src"), parentId)
+
+		statement, err := i.yulNode(node, wrapper.GetId())
+		if err != nil {
+			return nil, err
+		}
+
+		wrapper.Statements = append(wrapper.Statements, statement)
+		toReturn = append(toReturn, wrapper)
+	}
+
+	return toReturn, nil
+}
+
+// yulBlock converts solc YulBlock into YulBlockStatement.
+func (i *solcImporter) yulBlock(node solcNode, parentId int64) (*YulBlockStatement, error) {
+	toReturn := NewYulBlockStatement(i.ASTBuilder)
+	toReturn.Src = i.src(node.str("src"), parentId)
+
+	statements, err := i.yulStatements(node.nodes("statements"), toReturn.GetId())
+	if err != nil {
+		return nil, err
+	}
+	toReturn.Statements = statements
+
+	return toReturn, nil
+}
+
+// yulIdentifier converts solc YulIdentifier or YulTypedName into YulIdentifier.
+func (i *solcImporter) yulIdentifier(node solcNode, parentId int64) *YulIdentifier {
+	return &YulIdentifier{
+		ASTBuilder: i.ASTBuilder,
+		Id:         i.GetNextID(),
+		NodeType:   ast_pb.NodeType_YUL_IDENTIFIER,
+		Src:        i.src(node.str("src"), parentId),
+		Name:       node.str("name"),
+	}
+}
+
+// yulIdentifiers converts a list of solc yul identifiers.
+func (i *solcImporter) yulIdentifiers(nodes []solcNode, parentId int64) []*YulIdentifier {
+	toReturn := make([]*YulIdentifier, 0, len(nodes))
+	for _, node := range nodes {
+		toReturn = append(toReturn, i.yulIdentifier(node, parentId))
+	}
+	return toReturn
+}
+
+// yulNode converts solc yul statements and expressions into solgo yul nodes.
+func (i *solcImporter) yulNode(node solcNode, parentId int64) (Node[NodeType], error) {
+	switch node.nodeType() {
+	case "YulBlock":
+		block, err := i.yulBlock(node, parentId)
+		if err != nil {
+			return nil, err
+		}
+		return block, nil
+	case "YulIdentifier":
+		return i.yulIdentifier(node, parentId), nil
+	case "YulLiteral":
+		return i.yulLiteral(node, parentId), nil
+	case "YulFunctionCall":
+		toReturn := NewYulFunctionCallStatement(i.ASTBuilder)
+		toReturn.Src = i.src(node.str("src"), parentId)
+		toReturn.FunctionName = i.yulIdentifier(node.node("functionName"), toReturn.GetId())
+		toReturn.Arguments = make([]Node[NodeType], 0)
+
+		for _, argument := range node.nodes("arguments") {
+			expr, err := i.yulNode(argument, toReturn.GetId())
+			if err != nil {
+				return nil, err
+			}
+			toReturn.Arguments = append(toReturn.Arguments, expr)
+		}
+
+		return toReturn, nil
+	case "YulExpressionStatement":
+		// Same as when parsing, expression statements are represented by the expression itself.
+		return i.yulNode(node.node("expression"), parentId)
+	case "YulVariableDeclaration":
+		toReturn := NewYulVariable(i.ASTBuilder)
+		toReturn.Src = i.src(node.str("src"), parentId)
+		toReturn.Let = true
+		toReturn.Variables = i.yulIdentifiers(node.nodes("variables"), toReturn.GetId())
+
+		if value := node.node("value"); value != nil {
+			expr, err := i.yulNode(value, toReturn.GetId())
+			if err != nil {
+				return nil, err
+			}
+			toReturn.Value = expr
+		} else {
+			// Variables declared without value are zero initialised.
+			zero := NewYulLiteralStatement(i.ASTBuilder)
+			zero.Src = toReturn.Src
+			zero.Src.ParentIndex = toReturn.GetId()
+			zero.Kind = ast_pb.NodeType_DECIMAL_NUMBER
+			zero.Value = "0"
+			toReturn.Value = zero
+		}
+
+		return toReturn, nil
+	case "YulAssignment":
+		value, err := i.yulNode(node.node("value"), parentId)
+		if err != nil {
+			return nil, err
+		}
+
+		toReturn := NewYulAssignment(i.ASTBuilder)
+		toReturn.Src = i.src(node.str("src"), parentId)
+		toReturn.VariableNames = i.yulIdentifiers(node.nodes("variableNames"), toReturn.GetId())
+		toReturn.Value = value
+		return toReturn, nil
+	case "YulIf":
+		toReturn := NewYulIfStatement(i.ASTBuilder)
+		toReturn.Src = i.src(node.str("src"), parentId)
+
+		condition, err := i.yulNode(node.node("condition"), toReturn.GetId())
+		if err != nil {
+			return nil, err
+		}
+		toReturn.Condition = condition
+
+		body, err := i.yulBlock(node.node("body"), toReturn.GetId())
+		if err != nil {
+			return nil, err
+		}
+		toReturn.Body = body
+
+		return toReturn, nil
+	case "YulSwitch":
+		toReturn := NewYulSwitchStatement(i.ASTBuilder)
+		toReturn.Src = i.src(node.str("src"), parentId)
+		toReturn.Cases = make([]Node[NodeType], 0)
+
+		expression, err := i.yulNode(node.node("expression"), toReturn.GetId())
+		if err != nil {
+			return nil, err
+		}
+		toReturn.Expression = expression
+
+		for _, caseNode := range node.nodes("cases") {
+			switchCase := NewYulSwitchCaseStatement(i.ASTBuilder)
+			switchCase.Src = i.src(caseNode.str("src"), toReturn.GetId())
+
+			// Default case has `"value": "default"` instead of the literal node.
+			if value := caseNode.node("value"); value != nil {
+				switchCase.Case = i.yulLiteral(value, switchCase.GetId())
+			}
+
+			body, err := i.yulBlock(caseNode.node("body"), switchCase.GetId())
+			if err != nil {
+				return nil, err
+			}
+			switchCase.Body = body
+
+			toReturn.Cases = append(toReturn.Cases, switchCase)
+		}
+
+		return toReturn, nil
+	case "YulForLoop":
+		toReturn := NewYulForStatement(i.ASTBuilder)
+		toReturn.Src = i.src(node.str("src"), parentId)
+
+		pre, err := i.yulBlock(node.node("pre"), toReturn.GetId())
+		if err != nil {
+			return nil, err
+		}
+		toReturn.Pre = pre
+
+		condition, err := i.yulNode(node.node("condition"), toReturn.GetId())
+		if err != nil {
+			return nil, err
+		}
+		toReturn.Condition = condition
+
+		post, err := i.yulBlock(node.node("post"), toReturn.GetId())
+		if err != nil {
+			return nil, err
+		}
+		toReturn.Post = post
+
+		body, err := i.yulBlock(node.node("body"), toReturn.GetId())
+		if err != nil {
+			return nil, err
+		}
+		toReturn.Body = body
+
+		return toReturn, nil
+	case "YulFunctionDefinition":
+		toReturn := NewYulFunctionDefinition(i.ASTBuilder)
+		toReturn.Src = i.src(node.str("src"), parentId)
+		toReturn.Name = node.str("name")
+		toReturn.Arguments = i.yulIdentifiers(node.nodes("parameters"), toReturn.GetId())
+		toReturn.ReturnParameters = i.yulIdentifiers(node.nodes("returnVariables"), toReturn.GetId())
+
+		body, err := i.yulBlock(node.node("body"), toReturn.GetId())
+		if err != nil {
+			return nil, err
+		}
+		toReturn.Body = body
+
+		return toReturn, nil
+	case "YulBreak":
+		toReturn := NewYulBreakStatement(i.ASTBuilder)
+		toReturn.Src = i.src(node.str("src"), parentId)
+		return toReturn, nil
+	case "YulContinue":
+		toReturn := NewYulContinueStatement(i.ASTBuilder)
+		toReturn.Src = i.src(node.str("src"), parentId)
+		return toReturn, nil
+	case "YulLeave":
+		toReturn := NewYulLeaveStatement(i.ASTBuilder)
+		toReturn.Src = i.src(node.str("src"), parentId)
+		return toReturn, nil
+	default:
+		return nil, fmt.Errorf("unsupported solc yul node type %q at %s", node.nodeType(), node.str("src"))
+	}
+}
+
+// yulLiteral converts solc YulLiteral into YulLiteralStatement.
+func (i *solcImporter) yulLiteral(node solcNode, parentId int64) *YulLiteralStatement {
+	toReturn := NewYulLiteralStatement(i.ASTBuilder)
+	toReturn.Src = i.src(node.str("src"), parentId)
+	toReturn.Value = node.str("value")
+	toReturn.HexValue = node.str("hexValue")
+
+	switch node.str("kind") {
+	case "bool":
+		toReturn.Kind = ast_pb.NodeType_BOOLEAN
+	case "string":
+		toReturn.Kind = ast_pb.NodeType_STRING
+	default:
+		toReturn.Kind = ast_pb.NodeType_DECIMAL_NUMBER
+
+		// Same as when parsing, hex numbers keep the hex value and decimal value.
+		if strings.HasPrefix(toReturn.Value, "0x") {
+			toReturn.Kind = ast_pb.NodeType_HEX_NUMBER
+			toReturn.HexValue = toReturn.Value
+			if value, ok := new(big.Int).SetString(strings.TrimPrefix(toReturn.HexValue, "0x"), 16); ok {
+				toReturn.Value = value.String()
+			}
+		}
+	}
+
+	return toReturn
+}
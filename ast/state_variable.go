@@ -11,19 +11,21 @@ import (
 // StateVariableDeclaration represents a state variable declaration in the Solidity abstract syntax tree (AST).
 type StateVariableDeclaration struct {
 	*ASTBuilder                            // Embedding the ASTBuilder for common functionality
-	Id              int64                  `json:"id"`                // Unique identifier for the state variable declaration
-	Name            string                 `json:"name"`              // Name of the state variable
-	Constant        bool                   `json:"is_constant"`       // Indicates if the state variable is constant
-	StateVariable   bool                   `json:"is_state_variable"` // Indicates if the declaration is a state variable
-	NodeType        ast_pb.NodeType        `json:"node_type"`         // Type of the node (VARIABLE_DECLARATION for state variable declaration)
-	Src             SrcNode                `json:"src"`               // Source information about the state variable declaration
-	Scope           int64                  `json:"scope"`             // Scope of the state variable declaration
-	TypeDescription *TypeDescription       `json:"type_description"`  // Type description of the state variable declaration
-	Visibility      ast_pb.Visibility      `json:"visibility"`        // Visibility of the state variable declaration
-	StorageLocation ast_pb.StorageLocation `json:"storage_location"`  // Storage location of the state variable declaration
-	StateMutability ast_pb.Mutability      `json:"mutability"`        // State mutability of the state variable declaration
-	TypeName        *TypeName              `json:"type_name"`         // Type name of the state variable
-	InitialValue    Node[NodeType]         `json:"initial_value"`     // Initial value of the state variable
+	Id              int64                  `json:"id"`                      // Unique identifier for the state variable declaration
+	Name            string                 `json:"name"`                    // Name of the state variable
+	Constant        bool                   `json:"is_constant"`             // Indicates if the state variable is constant
+	Transient       bool                   `json:"is_transient"`            // Indicates if the state variable is stored in the transient storage
+	StateVariable   bool                   `json:"is_state_variable"`       // Indicates if the declaration is a state variable
+	NodeType        ast_pb.NodeType        `json:"node_type"`               // Type of the node (VARIABLE_DECLARATION for state variable declaration)
+	Src             SrcNode                `json:"src"`                     // Source information about the state variable declaration
+	Scope           int64                  `json:"scope"`                   // Scope of the state variable declaration
+	TypeDescription *TypeDescription       `json:"type_description"`        // Type description of the state variable declaration
+	Visibility      ast_pb.Visibility      `json:"visibility"`              // Visibility of the state variable declaration
+	StorageLocation ast_pb.StorageLocation `json:"storage_location"`        // Storage location of the state variable declaration
+	StateMutability ast_pb.Mutability      `json:"mutability"`              // State mutability of the state variable declaration
+	TypeName        *TypeName              `json:"type_name"`               // Type name of the state variable
+	InitialValue    Node[NodeType]         `json:"initial_value"`           // Initial value of the state variable
+	Documentation   *NatSpec               `json:"documentation,omitempty"` // NatSpec documentation of the state variable
 }
 
 // NewStateVariableDeclaration creates a new StateVariableDeclaration instance.
@@ -75,6 +77,11 @@ func (v *StateVariableDeclaration) GetSrc() SrcNode {
 	return v.Src
 }
 
+// GetDocumentation returns the NatSpec documentation of the state variable, or nil if it is not documented.
+func (v *StateVariableDeclaration) GetDocumentation() *NatSpec {
+	return v.Documentation
+}
+
 // GetTypeDescription returns the type description of the state variable declaration.
 func (v *StateVariableDeclaration) GetTypeDescription() *TypeDescription {
 	return v.TypeDescription
@@ -135,6 +142,11 @@ func (v *StateVariableDeclaration) IsConstant() bool {
 	return v.Constant
 }
 
+// IsTransient returns whether the state variable is declared with the transient data location.
+func (v *StateVariableDeclaration) IsTransient() bool {
+	return v.Transient
+}
+
 // IsStateVariable returns whether the declaration is a state variable.
 func (v *StateVariableDeclaration) IsStateVariable() bool {
 	return v.StateVariable
@@ -194,6 +206,7 @@ func (v *StateVariableDeclaration) Parse(
 		Length:      int64(ctx.GetStop().GetStop() - ctx.GetStart().GetStart() + 1),
 		ParentIndex: contractNode.GetId(),
 	}
+	v.Documentation = v.getDocumentationFromCtx(ctx)
 	v.Scope = contractNode.GetId()
 	v.Visibility = v.getVisibilityFromCtx(ctx)
 
@@ -207,6 +220,8 @@ func (v *StateVariableDeclaration) Parse(
 		v.Constant = constantCtx != nil
 	}
 
+	v.Transient = v.isTransientFromCtx(ctx)
+
 	typeName := NewTypeName(v.ASTBuilder)
 
 	typeName.Parse(unit, nil, v.Id, ctx.GetType_())
@@ -258,6 +273,7 @@ func (v *StateVariableDeclaration) ParseGlobal(
 		Length:      int64(ctx.GetStop().GetStop() - ctx.GetStart().GetStart() + 1),
 		ParentIndex: 0,
 	}
+	v.Documentation = v.getDocumentationFromCtx(ctx)
 	v.Visibility = v.getVisibilityFromCtx(ctx)
 
 	for _, immutableCtx := range ctx.AllImmutable() {
@@ -270,6 +286,8 @@ func (v *StateVariableDeclaration) ParseGlobal(
 		v.Constant = constantCtx != nil
 	}
 
+	v.Transient = v.isTransientFromCtx(ctx)
+
 	typeName := NewTypeName(v.ASTBuilder)
 	typeName.Parse(nil, nil, v.Id, ctx.GetType_())
 	v.TypeName = typeName
@@ -300,6 +318,7 @@ func (v *StateVariableDeclaration) ParseGlobalConstant(
 		Length:      int64(ctx.GetStop().GetStop() - ctx.GetStart().GetStart() + 1),
 		ParentIndex: 0,
 	}
+	v.Documentation = v.getDocumentationFromCtx(ctx)
 	v.Visibility = ast_pb.Visibility_PUBLIC
 	v.Constant = true
 
@@ -0,0 +1,109 @@
+package ast
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/unpackdev/solgo/parser"
+)
+
+// StorageLayoutSpecifier represents the `layout at <expression>` specifier of the contract, introduced in
+// Solidity 0.8.29, which moves the storage of the contract and its bases to the custom base slot.
+type StorageLayoutSpecifier struct {
+	Src                SrcNode `json:"src"`                  // Source information about the specifier.
+	BaseSlotExpression string  `json:"base_slot_expression"` // Expression of the base slot, e.g. `0x1234`.
+}
+
+// GetSrc returns the source information about the storage layout specifier.
+func (s *StorageLayoutSpecifier) GetSrc() SrcNode {
+	return s.Src
+}
+
+// GetBaseSlotExpression returns the expression of the base slot of the storage layout.
+func (s *StorageLayoutSpecifier) GetBaseSlotExpression() string {
+	return s.BaseSlotExpression
+}
+
+// getHiddenTokens returns the tokens within the provided context which are hidden from the parser, except
+// the comments. Syntax newer than the grammar, such as the `transient` data location, ends up in there.
+func (b *ASTBuilder) getHiddenTokens(ctx antlr.ParserRuleContext) []antlr.Token {
+	toReturn := make([]antlr.Token, 0)
+	if b.parser == nil || ctx == nil || ctx.GetStart() == nil || ctx.GetStop() == nil {
+		return toReturn
+	}
+
+	stream, ok := b.parser.GetTokenStream().(*antlr.CommonTokenStream)
+	if !ok {
+		return toReturn
+	}
+
+	tokens := stream.GetAllTokens()
+	for index := ctx.GetStart().GetTokenIndex(); index >= 0 && index <= ctx.GetStop().GetTokenIndex() && index < len(tokens); index++ {
+		token := tokens[index]
+		if token.GetChannel() == antlr.TokenDefaultChannel ||
+			token.GetTokenType() == parser.SolidityLexerCOMMENT ||
+			token.GetTokenType() == parser.SolidityLexerLINE_COMMENT {
+			continue
+		}
+		toReturn = append(toReturn, token)
+	}
+
+	return toReturn
+}
+
+// isTransientFromCtx reports whether the state variable is declared with the `transient` data location.
+func (b *ASTBuilder) isTransientFromCtx(ctx *parser.StateVariableDeclarationContext) bool {
+	for _, token := range b.getHiddenTokens(ctx) {
+		if token.GetTokenType() == parser.SolidityLexerIdentifier && token.GetText() == "transient" {
+			return true
+		}
+	}
+	return false
+}
+
+// getStorageLayoutFromCtx returns the storage layout specifier of the contract, or nil if the contract
+// is using the default storage layout.
+func (b *ASTBuilder) getStorageLayoutFromCtx(ctx *parser.ContractDefinitionContext, contractId int64) *StorageLayoutSpecifier {
+	var toReturn *StorageLayoutSpecifier
+	var first, last antlr.Token
+
+	for _, token := range b.getHiddenTokens(ctx) {
+		// Specifier is a part of the contract header, preceding the contract body.
+		if ctx.LBrace() != nil && token.GetTokenIndex() > ctx.LBrace().GetSymbol().GetTokenIndex() {
+			break
+		}
+
+		if toReturn == nil {
+			if token.GetText() == "layout" {
+				toReturn = &StorageLayoutSpecifier{
+					Src: SrcNode{
+						Line:        int64(token.GetLine()),
+						Column:      int64(token.GetColumn()),
+						Start:       int64(token.GetStart()),
+						ParentIndex: contractId,
+					},
+				}
+				last = token
+			}
+			continue
+		}
+
+		// Tokens following the `layout` are the `at` keyword and the tokens of the expression.
+		if first == nil && last.GetText() != "layout" {
+			first = token
+		}
+		last = token
+	}
+
+	if toReturn == nil {
+		return nil
+	}
+
+	toReturn.Src.End = int64(last.GetStop())
+	toReturn.Src.Length = toReturn.Src.End - toReturn.Src.Start + 1
+	if first != nil && first.GetInputStream() != nil {
+		toReturn.BaseSlotExpression = first.GetInputStream().GetTextFromInterval(
+			antlr.NewInterval(first.GetStart(), last.GetStop()),
+		)
+	}
+
+	return toReturn
+}
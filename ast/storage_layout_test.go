@@ -0,0 +1,103 @@
+package ast
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unpackdev/solgo"
+)
+
+func TestRecentSyntax(t *testing.T) {
+	content := `// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.29;
+
+type Fixed is int256;
+
+function add(Fixed a, Fixed b) pure returns (Fixed) {
+    return Fixed.wrap(Fixed.unwrap(a) + Fixed.unwrap(b));
+}
+
+function eq(Fixed a, Fixed b) pure returns (bool) {
+    return Fixed.unwrap(a) == Fixed.unwrap(b);
+}
+
+using {add as +, eq as ==} for Fixed global;
+
+contract Vault layout at 0x1234 + 1 {
+    uint256 transient locked;
+    address transient public caller;
+    uint256 transient;
+    Fixed public total;
+
+    modifier nonReentrant() {
+        require(locked == 0);
+        locked = 1;
+        _;
+        locked = 0;
+    }
+
+    function deposit(Fixed amount) external nonReentrant {
+        total = total + amount;
+        assembly {
+            tstore(0, caller())
+            let value := tload(0)
+            mcopy(0, 32, 32)
+            let hash := blobhash(0)
+            let fee := blobbasefee()
+        }
+    }
+}
+`
+
+	parser, err := solgo.NewParser(context.TODO(), strings.NewReader(content))
+	require.NoError(t, err)
+
+	builder := NewAstBuilder(parser.GetParser(), &solgo.Sources{
+		SourceUnits: []*solgo.SourceUnit{
+			{
+				Name:    "Vault",
+				Path:    "Vault.sol",
+				Content: content,
+			},
+		},
+		EntrySourceUnitName: "Vault",
+	})
+	require.NoError(t, parser.RegisterListener(solgo.ListenerAst, builder))
+	require.Empty(t, parser.Parse())
+
+	root := builder.GetRoot()
+	require.Len(t, root.GetSourceUnits(), 1)
+
+	contract, ok := root.GetSourceUnits()[0].GetContract().(*Contract)
+	require.True(t, ok)
+	require.NotNil(t, contract.GetStorageLayout())
+	assert.Equal(t, "0x1234 + 1", contract.GetStorageLayout().GetBaseSlotExpression())
+	assert.Equal(t, "layout at 0x1234 + 1", content[contract.GetStorageLayout().GetSrc().Start:contract.GetStorageLayout().GetSrc().End+1])
+
+	variables := contract.GetStateVariables()
+	require.Len(t, variables, 4)
+	assert.Equal(t, "locked", variables[0].GetName())
+	assert.True(t, variables[0].IsTransient())
+	assert.Equal(t, "caller", variables[1].GetName())
+	assert.True(t, variables[1].IsTransient())
+	assert.Equal(t, "transient", variables[2].GetName())
+	assert.False(t, variables[2].IsTransient())
+	assert.Equal(t, "total", variables[3].GetName())
+	assert.False(t, variables[3].IsTransient())
+
+	var using *UsingDirective
+	for _, node := range root.GetGlobalNodes() {
+		if directive, ok := node.(*UsingDirective); ok {
+			using = directive
+		}
+	}
+	require.NotNil(t, using)
+	assert.True(t, using.IsGlobal())
+	assert.Equal(t, "Fixed", using.GetPathNode().Name)
+	require.Len(t, using.GetFunctions(), 2)
+	assert.Equal(t, &UsingFunction{Name: "add", Operator: "+", Src: using.GetFunctions()[0].Src}, using.GetFunctions()[0])
+	assert.Equal(t, &UsingFunction{Name: "eq", Operator: "==", Src: using.GetFunctions()[1].Src}, using.GetFunctions()[1])
+}
@@ -2,6 +2,8 @@ package ast
 
 import (
 	"fmt"
+	"strings"
+
 	"github.com/goccy/go-json"
 
 	ast_pb "github.com/unpackdev/protos/dist/go/ast"
@@ -23,6 +25,7 @@ type StructDefinition struct {
 	Members               []Node[NodeType]       `json:"members"`                          // Members of the struct definition
 	Visibility            ast_pb.Visibility      `json:"visibility"`                       // Visibility of the struct definition
 	StorageLocation       ast_pb.StorageLocation `json:"storage_location"`                 // Storage location of the struct definition
+	Documentation         *NatSpec               `json:"documentation,omitempty"`          // NatSpec documentation of the struct definition
 }
 
 // NewStructDefinition creates a new StructDefinition instance.
@@ -90,6 +93,26 @@ func (s *StructDefinition) GetMembers() []*Parameter {
 	return toReturn
 }
 
+// GetDocumentation returns the NatSpec documentation of the struct definition, or nil if it is not documented.
+func (s *StructDefinition) GetDocumentation() *NatSpec {
+	return s.Documentation
+}
+
+// GetStorageNamespace returns the ERC-7201 namespace id of the struct annotated with
+// `@custom:storage-location erc7201:<id>`, or an empty string if the struct is not annotated.
+func (s *StructDefinition) GetStorageNamespace() string {
+	if s.Documentation == nil {
+		return ""
+	}
+
+	location := strings.TrimSpace(s.Documentation.GetCustom()["storage-location"])
+	if !strings.HasPrefix(location, "erc7201:") {
+		return ""
+	}
+
+	return strings.TrimPrefix(location, "erc7201:")
+}
+
 // GetSourceUnitName returns the name of the source unit.
 func (s *StructDefinition) GetSourceUnitName() string {
 	return s.SourceUnitName
@@ -181,6 +204,12 @@ func (s *StructDefinition) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	if documentation, ok := tempMap["documentation"]; ok {
+		if err := json.Unmarshal(documentation, &s.Documentation); err != nil {
+			return err
+		}
+	}
+
 	if members, ok := tempMap["members"]; ok {
 		var nodes []json.RawMessage
 		if err := json.Unmarshal(members, &nodes); err != nil {
@@ -248,6 +277,7 @@ func (s *StructDefinition) Parse(
 		ParentIndex: unit.GetId(),
 	}
 	s.SourceUnitName = unit.GetName()
+	s.Documentation = s.getDocumentationFromCtx(ctx)
 
 	s.Name = ctx.GetName().GetText()
 	s.CanonicalName = fmt.Sprintf("%s.%s", s.SourceUnitName, s.Name)
@@ -292,6 +322,7 @@ func (s *StructDefinition) ParseGlobal(
 		ParentIndex: 0,
 	}
 	s.SourceUnitName = "Global"
+	s.Documentation = s.getDocumentationFromCtx(ctx)
 
 	s.Name = ctx.GetName().GetText()
 	s.CanonicalName = fmt.Sprintf("%s.%s", s.SourceUnitName, s.Name)
@@ -483,7 +483,7 @@ func (t *TypeName) parseIdentifierPath(unit *SourceUnit[Node[ast_pb.SourceUnit]]
 		identifierCtx := ctx.Identifier(0)
 		t.PathNode = &PathNode{
 			Id:   t.GetNextID(),
-			Name: identifierCtx.GetText(),
+			Name: ctx.GetText(), // Full path, e.g. Library.Struct, same as the compiler does.
 			Src: SrcNode{
 				Line:        int64(ctx.GetStart().GetLine()),
 				Column:      int64(ctx.GetStart().GetColumn()),
@@ -740,10 +740,17 @@ func (t *TypeName) parseFunctionTypeName(unit *SourceUnit[Node[ast_pb.SourceUnit
 }
 
 func (t *TypeName) parsePrimaryExpression(unit *SourceUnit[Node[ast_pb.SourceUnit]], fnNode Node[NodeType], parentNodeId int64, ctx *parser.PrimaryExpressionContext) {
-	t.Name = "function"
-	t.NodeType = ast_pb.NodeType_IDENTIFIER
 	statement := NewPrimaryExpression(t.ASTBuilder)
 	t.Expression = statement.Parse(unit, nil, fnNode, nil, nil, nil, parentNodeId, ctx)
+
+	// Primary expression following the base type is the length of the fixed size array, e.g. uint256[49],
+	// in which case the array type discovered from the base type has to be kept.
+	if t.Name != "" {
+		return
+	}
+
+	t.Name = "function"
+	t.NodeType = ast_pb.NodeType_IDENTIFIER
 	t.TypeDescription = t.Expression.GetTypeDescription()
 }
 
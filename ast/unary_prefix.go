@@ -270,6 +270,9 @@ func (u *UnaryPrefix) Parse(
 		u.Operator = ast_pb.Operator_BIT_NOT
 	} else if ctx.Sub() != nil {
 		u.Operator = ast_pb.Operator_SUBTRACT
+	} else if ctx.Delete() != nil {
+		// There is no delete operator, default operator is used for it same as in solc import.
+		u.Operator = ast_pb.Operator_O_DEFAULT
 	}
 
 	expression := NewExpression(u.ASTBuilder)
@@ -15,6 +15,16 @@ type UsingDirective struct {
 	TypeDescription *TypeDescription `json:"type_description"`
 	TypeName        *TypeName        `json:"type_name"`
 	LibraryName     *LibraryName     `json:"library_name"`
+	Functions       []*UsingFunction `json:"function_list,omitempty"`
+	Global          bool             `json:"global"`
+}
+
+// UsingFunction represents a free or library function attached to the type by the `using {f as +} for T`
+// directive, optionally bound to the user-defined operator.
+type UsingFunction struct {
+	Name     string  `json:"name"`               // Name of the function.
+	Operator string  `json:"operator,omitempty"` // User-defined operator the function implements, if any.
+	Src      SrcNode `json:"src"`                // Source information about the function.
 }
 
 // LibraryName represents the name of an external library referenced in a using directive.
@@ -85,6 +95,16 @@ func (u *UsingDirective) GetLibraryName() *LibraryName {
 	return u.LibraryName
 }
 
+// GetFunctions returns the functions listed in the UsingDirective along with their operators.
+func (u *UsingDirective) GetFunctions() []*UsingFunction {
+	return u.Functions
+}
+
+// IsGlobal returns whether the UsingDirective applies to all the source units, e.g. `using L for T global`.
+func (u *UsingDirective) IsGlobal() bool {
+	return u.Global
+}
+
 // GetReferencedDeclaration returns the referenced declaration of the UsingDirective.
 func (u *UsingDirective) GetReferencedDeclaration() int64 {
 	return u.TypeName.ReferencedDeclaration
@@ -132,6 +152,8 @@ func (u *UsingDirective) Parse(
 	}
 
 	u.LibraryName = u.getLibraryName(ctx.IdentifierPath(0))
+	u.Global = ctx.Global() != nil
+	u.Functions = u.getFunctionsFromCtx(ctx)
 
 	if ctx.TypeName() != nil {
 		typeName := NewTypeName(u.ASTBuilder)
@@ -146,6 +168,67 @@ func (u *UsingDirective) Parse(
 	}
 }
 
+// ParseGlobal populates the UsingDirective instance declared at the source unit level, outside of the contracts.
+// Such directives are the only ones which can bind the functions to the user-defined operators.
+func (u *UsingDirective) ParseGlobal(ctx *parser.UsingDirectiveContext) {
+	u.Src = SrcNode{
+		Line:        int64(ctx.GetStart().GetLine()),
+		Column:      int64(ctx.GetStart().GetColumn()),
+		Start:       int64(ctx.GetStart().GetStart()),
+		End:         int64(ctx.GetStop().GetStop()),
+		Length:      int64(ctx.GetStop().GetStop() - ctx.GetStart().GetStart() + 1),
+		ParentIndex: 0,
+	}
+
+	u.LibraryName = u.getLibraryName(ctx.IdentifierPath(0))
+	u.Global = ctx.Global() != nil
+	u.Functions = u.getFunctionsFromCtx(ctx)
+
+	typeName := NewTypeName(u.ASTBuilder)
+	if ctx.TypeName() != nil {
+		typeName.Parse(nil, nil, u.GetId(), ctx.TypeName())
+	} else if ctx.Mul() != nil {
+		typeName.ParseMul(nil, nil, u.GetId(), ctx.Mul())
+	}
+	u.TypeName = typeName
+	u.TypeDescription = typeName.TypeDescription
+
+	u.globalDefinitions = append(u.globalDefinitions, u)
+}
+
+// getFunctionsFromCtx extracts the functions of the `using {f, g as +} for T` form of the directive
+// along with the user-defined operators they are bound to.
+func (u *UsingDirective) getFunctionsFromCtx(ctx *parser.UsingDirectiveContext) []*UsingFunction {
+	if ctx.LBrace() == nil {
+		return nil
+	}
+
+	toReturn := make([]*UsingFunction, 0)
+	for _, child := range ctx.GetChildren() {
+		switch childCtx := child.(type) {
+		case *parser.IdentifierPathContext:
+			toReturn = append(toReturn, &UsingFunction{
+				Name: childCtx.GetText(),
+				Src: SrcNode{
+					Line:        int64(childCtx.GetStart().GetLine()),
+					Column:      int64(childCtx.GetStart().GetColumn()),
+					Start:       int64(childCtx.GetStart().GetStart()),
+					End:         int64(childCtx.GetStop().GetStop()),
+					Length:      int64(childCtx.GetStop().GetStop() - childCtx.GetStart().GetStart() + 1),
+					ParentIndex: u.Id,
+				},
+			})
+		case *parser.UserDefinableOperatorContext:
+			// Operator follows the function it is bound to.
+			if len(toReturn) > 0 {
+				toReturn[len(toReturn)-1].Operator = childCtx.GetText()
+			}
+		}
+	}
+
+	return toReturn
+}
+
 // getLibraryName extracts and returns the LibraryName instance from the provided identifier context.
 func (u *UsingDirective) getLibraryName(identifierCtx parser.IIdentifierPathContext) *LibraryName {
 	return &LibraryName{
@@ -171,3 +254,13 @@ func (u *UsingDirective) getLibraryName(identifierCtx parser.IIdentifierPathCont
 		}(),
 	}
 }
+
+func (b *ASTBuilder) EnterUsingDirective(ctx *parser.UsingDirectiveContext) {
+	// Directives within the contracts are parsed along with the contract body.
+	if _, ok := ctx.GetParent().(*parser.SourceUnitContext); !ok {
+		return
+	}
+
+	child := NewUsingDirective(b)
+	child.ParseGlobal(ctx)
+}
@@ -1,6 +1,7 @@
 package ast
 
 import (
+	"github.com/antlr4-go/antlr/v4"
 	"github.com/goccy/go-json"
 
 	v3 "github.com/cncf/xds/go/xds/type/v3"
@@ -208,11 +209,25 @@ func (v *VariableDeclaration) Parse(
 	}
 
 	if ctx.VariableDeclarationTuple() != nil {
-		for _, declarationCtx := range ctx.VariableDeclarationTuple().AllVariableDeclaration() {
-			declaration := NewDeclaration(v.ASTBuilder)
-			declaration.ParseVariableDeclaration(unit, contractNode, fnNode, bodyNode, v, declarationCtx)
-			v.Declarations = append(v.Declarations, declaration)
-			v.Assignments = append(v.Assignments, declaration.GetId())
+		// Tuple destructuring may skip components, e.g. `(, uint256 b) = f();`, and same as the
+		// compiler does, skipped components are recorded as assignments without declaration.
+		skipped := true
+		for _, child := range ctx.VariableDeclarationTuple().GetChildren() {
+			switch childCtx := child.(type) {
+			case *parser.VariableDeclarationContext:
+				declaration := NewDeclaration(v.ASTBuilder)
+				declaration.ParseVariableDeclaration(unit, contractNode, fnNode, bodyNode, v, childCtx)
+				v.Declarations = append(v.Declarations, declaration)
+				v.Assignments = append(v.Assignments, declaration.GetId())
+				skipped = false
+			case antlr.TerminalNode:
+				if text := childCtx.GetText(); text == "," || text == ")" {
+					if skipped {
+						v.Assignments = append(v.Assignments, 0)
+					}
+					skipped = true
+				}
+			}
 		}
 	}
 
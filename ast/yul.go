@@ -98,9 +98,8 @@ func (a *Yul) Parse(
 	a.Body.NodeType = ast_pb.NodeType_YUL_BLOCK
 	a.Body.Statements = make([]Node[NodeType], 0)
 
-	yulStatement := NewYulStatement(a.ASTBuilder)
-
 	for _, yulCtx := range ctx.AllYulStatement() {
+		yulStatement := NewYulStatement(a.ASTBuilder)
 		a.Body.Statements = append(a.Body.Statements,
 			yulStatement.Parse(
 				unit, contractNode, fnNode, a.Body, a, a, yulCtx.(*parser.YulStatementContext),
@@ -154,6 +154,10 @@ func (y *YulExpressionStatement) Parse(
 		ParentIndex: parentNode.GetId(),
 	}
 
+	if ctx.YulPath() != nil {
+		y.Expression = parseYulPath(y.ASTBuilder, parentNode, ctx.YulPath())
+	}
+
 	if ctx.YulLiteral() != nil {
 		literalStatement := NewYulLiteralStatement(y.ASTBuilder)
 		y.Expression = literalStatement.Parse(
@@ -187,6 +191,10 @@ func ParseYulExpression(
 	parentNode Node[NodeType],
 	ctx parser.IYulExpressionContext,
 ) Node[NodeType] {
+	if ctx.YulPath() != nil {
+		return parseYulPath(b, parentNode, ctx.YulPath())
+	}
+
 	if ctx.YulLiteral() != nil {
 		literalStatement := NewYulLiteralStatement(b)
 		return literalStatement.Parse(
@@ -205,3 +213,22 @@ func ParseYulExpression(
 
 	return nil
 }
+
+// parseYulPath parses a YUL path, such as `x` or `x.slot`, into a single YUL identifier
+// holding the full path as its name.
+func parseYulPath(b *ASTBuilder, parentNode Node[NodeType], ctx parser.IYulPathContext) *YulIdentifier {
+	return &YulIdentifier{
+		ASTBuilder: b,
+		Id:         b.GetNextID(),
+		NodeType:   ast_pb.NodeType_YUL_IDENTIFIER,
+		Name:       ctx.GetText(),
+		Src: SrcNode{
+			Line:        int64(ctx.GetStart().GetLine()),
+			Column:      int64(ctx.GetStart().GetColumn()),
+			Start:       int64(ctx.GetStart().GetStart()),
+			End:         int64(ctx.GetStop().GetStop()),
+			Length:      int64(ctx.GetStop().GetStop() - ctx.GetStart().GetStart() + 1),
+			ParentIndex: parentNode.GetId(),
+		},
+	}
+}
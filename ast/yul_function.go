@@ -21,6 +21,9 @@ type YulFunctionDefinition struct {
 	// Src is the source location information of the YUL function definition.
 	Src SrcNode `json:"src"`
 
+	// Name is the name of the YUL function definition.
+	Name string `json:"name"`
+
 	// Arguments is a list of YUL identifiers representing function arguments.
 	Arguments []*YulIdentifier `json:"arguments"`
 
@@ -81,6 +84,11 @@ func (y *YulFunctionDefinition) GetTypeDescription() *TypeDescription {
 	return &TypeDescription{}
 }
 
+// GetName returns the name of the YUL function definition.
+func (y *YulFunctionDefinition) GetName() string {
+	return y.Name
+}
+
 // GetArguments returns the list of YUL identifiers representing function arguments.
 func (y *YulFunctionDefinition) GetArguments() []*YulIdentifier {
 	return y.Arguments
@@ -143,6 +151,12 @@ func (f *YulFunctionDefinition) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	if name, ok := tempMap["name"]; ok {
+		if err := json.Unmarshal(name, &f.Name); err != nil {
+			return err
+		}
+	}
+
 	if arguments, ok := tempMap["arguments"]; ok {
 		var nodes []json.RawMessage
 		if err := json.Unmarshal(arguments, &nodes); err != nil {
@@ -217,6 +231,10 @@ func (y *YulFunctionDefinition) Parse(
 		ParentIndex: statementNode.GetId(),
 	}
 
+	if ctx.YulIdentifier(0) != nil {
+		y.Name = ctx.YulIdentifier(0).GetText()
+	}
+
 	for _, argument := range ctx.GetArguments() {
 		y.Arguments = append(y.Arguments, &YulIdentifier{
 			Id:       y.GetNextID(),
@@ -52,8 +52,12 @@ func (y *YulSwitchCaseStatement) GetSrc() SrcNode {
 // GetNodes returns a list of nodes associated with the YulSwitchCaseStatement.
 func (y *YulSwitchCaseStatement) GetNodes() []Node[NodeType] {
 	toReturn := make([]Node[NodeType], 0)
-	toReturn = append(toReturn, y.Case)
-	toReturn = append(toReturn, y.Body)
+	if y.Case != nil {
+		toReturn = append(toReturn, y.Case)
+	}
+	if y.Body != nil {
+		toReturn = append(toReturn, y.Body)
+	}
 	return toReturn
 }
 
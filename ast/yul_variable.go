@@ -225,5 +225,14 @@ func (y *YulVariable) Parse(
 		)
 	}
 
+	// Declaration of multiple variables, e.g. `let a, b := f()`, holds the function call directly.
+	if ctx.YulFunctionCall() != nil {
+		fcStatement := NewYulFunctionCallStatement(y.ASTBuilder)
+		y.Value = fcStatement.Parse(
+			unit, contractNode, fnNode, bodyNode, assemblyNode, statementNode, y,
+			ctx.YulFunctionCall().(*parser.YulFunctionCallContext),
+		)
+	}
+
 	return y
 }
@@ -5,16 +5,19 @@ import (
 
 	"github.com/0x19/solc-switch"
 	"github.com/unpackdev/solgo"
+	"github.com/unpackdev/solgo/ir"
 )
 
 // Auditor represents a structure that manages the auditing process
-// of smart contracts using the Slither tool.
+// of smart contracts using the Slither tool and the native detectors.
 type Auditor struct {
 	ctx      context.Context // Context for the auditor operations.
 	config   *Config         // Configuration for the Slither tool.
 	sources  *solgo.Sources  // Sources of the smart contracts to be audited.
 	compiler *solc.Solc      // Instance of the solc compiler.
 	slither  *Slither        // Instance of the Slither tool.
+	native   *NativeAnalyzer // Analyzer running the native detectors.
+	registry *Registry       // Registry of the custom detectors.
 }
 
 // NewAuditor initializes a new Auditor instance with the provided context,
@@ -39,6 +42,8 @@ func NewAuditor(ctx context.Context, compiler *solc.Solc, config *Config, source
 		sources:  sources,
 		slither:  slither,
 		compiler: compiler,
+		native:   NewNativeAnalyzer(),
+		registry: NewRegistry(),
 	}, nil
 }
 
@@ -63,13 +68,94 @@ func (a *Auditor) GetSlither() *Slither {
 	return a.slither
 }
 
+// GetNativeAnalyzer returns the analyzer running the native detectors.
+func (a *Auditor) GetNativeAnalyzer() *NativeAnalyzer {
+	return a.native
+}
+
+// GetRegistry returns the registry of the custom detectors run next to Slither or the native detectors.
+func (a *Auditor) GetRegistry() *Registry {
+	return a.registry
+}
+
+// RegisterDetector adds the custom detector to the registry of the Auditor.
+func (a *Auditor) RegisterDetector(detector NativeDetector) error {
+	return a.registry.Register(detector)
+}
+
 // Analyze performs an analysis of the smart contracts using the Slither tool.
+// If Slither is not installed, the native detectors are used instead.
+// Findings of the registered custom detectors are merged into the report.
 // It returns the analysis response or an error if the analysis fails.
 func (a *Auditor) Analyze() (*Report, error) {
+	if !a.slither.IsInstalled() {
+		return a.AnalyzeNative()
+	}
+
 	response, _, err := a.slither.Analyze(a.sources)
 	if err != nil {
 		return nil, err
 	}
 
+	if a.registry.Len() == 0 {
+		return response, nil
+	}
+
+	root, err := a.buildIR()
+	if err != nil {
+		return nil, err
+	}
+
+	custom, err := a.registry.Analyze(root)
+	if err != nil {
+		return nil, err
+	}
+	response.Merge(custom)
+
+	return response, nil
+}
+
+// AnalyzeNative performs an analysis of the smart contracts using the native and the registered
+// custom detectors only. It builds the IR from the sources and does not require Slither to be installed.
+func (a *Auditor) AnalyzeNative() (*Report, error) {
+	root, err := a.buildIR()
+	if err != nil {
+		return nil, err
+	}
+
+	return a.AnalyzeIR(root)
+}
+
+// AnalyzeIR runs the native and the registered custom detectors over the already built IR root.
+func (a *Auditor) AnalyzeIR(root *ir.RootSourceUnit) (*Report, error) {
+	response, err := a.native.Analyze(root)
+	if err != nil {
+		return nil, err
+	}
+
+	custom, err := a.registry.Analyze(root)
+	if err != nil {
+		return nil, err
+	}
+	response.Merge(custom)
+
 	return response, nil
 }
+
+// buildIR parses the sources and builds the IR root used by the native and custom detectors.
+func (a *Auditor) buildIR() (*ir.RootSourceUnit, error) {
+	builder, err := ir.NewBuilderFromSources(a.ctx, a.sources)
+	if err != nil {
+		return nil, err
+	}
+
+	if errs := builder.Parse(); len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	if err := builder.Build(); err != nil {
+		return nil, err
+	}
+
+	return builder.GetRoot(), nil
+}
@@ -1,4 +1,6 @@
 // Package audit provides a comprehensive suite of tools for auditing
 // smart contracts. It integrates with the Slither static analysis tool
 // to facilitate in-depth contract analysis and ensures best practices in contract development.
+// When Slither is not available, native detectors implemented in Go over the IR are used instead.
+// Custom detectors can be registered with the Registry and their findings are merged into the report.
 package audit
@@ -11,4 +11,13 @@ var (
 
 	// ErrSourcesNotSet is returned when sources are not set
 	ErrSourcesNotSet = errors.New("sources are not set")
+
+	// ErrRootNotSet is returned when the IR root of the contracts to analyze is not set
+	ErrRootNotSet = errors.New("ir root is not set - make sure sources are parsed and built")
+
+	// ErrInvalidDetector is returned when the detector being registered is missing the check, impact, confidence or visitor
+	ErrInvalidDetector = errors.New("invalid detector - check, impact, confidence and visitor must be set")
+
+	// ErrDetectorExists is returned when the detector with the same check is already registered
+	ErrDetectorExists = errors.New("detector with the same check is already registered")
 )
@@ -0,0 +1,148 @@
+package audit
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/unpackdev/solgo/ast"
+	"github.com/unpackdev/solgo/ir"
+	"github.com/unpackdev/solgo/utils"
+)
+
+// NativeDetector represents a static analysis check implemented in Go over the IR and AST of
+// the contracts. Native detectors do not require Slither nor Python to be installed.
+type NativeDetector interface {
+	// Check returns the identifier of the check, e.g. "tx-origin".
+	Check() string
+	// Impact returns the severity of the issues found by the check.
+	Impact() ImpactLevel
+	// Confidence returns the confidence that the issues found by the check are real.
+	Confidence() ConfidenceLevel
+	// Detect searches for the issues in the provided IR root.
+	Detect(root *ir.RootSourceUnit) []Finding
+}
+
+// Finding represents a single issue found by the native detector.
+type Finding struct {
+	Description string    // Description of the issue.
+	Elements    []Element // Elements associated with the issue, the first one being the primary element.
+}
+
+// NativeAnalyzer runs the native detectors over the IR and produces the report in the same
+// format as the one produced by Slither.
+type NativeAnalyzer struct {
+	detectors []NativeDetector // Detectors executed by the analyzer.
+}
+
+// NewNativeAnalyzer initializes a new NativeAnalyzer with the provided detectors.
+// If no detectors are provided, the default native detectors are used.
+func NewNativeAnalyzer(detectors ...NativeDetector) *NativeAnalyzer {
+	if len(detectors) == 0 {
+		detectors = DefaultNativeDetectors()
+	}
+
+	return &NativeAnalyzer{
+		detectors: detectors,
+	}
+}
+
+// GetDetectors returns the detectors executed by the analyzer.
+func (a *NativeAnalyzer) GetDetectors() []NativeDetector {
+	return a.detectors
+}
+
+// Analyze runs all of the detectors over the provided IR root and returns the report.
+func (a *NativeAnalyzer) Analyze(root *ir.RootSourceUnit) (*Report, error) {
+	return runDetectors(root, a.detectors)
+}
+
+// runDetectors runs the provided detectors over the IR root and returns the report.
+func runDetectors(root *ir.RootSourceUnit, detectors []NativeDetector) (*Report, error) {
+	if root == nil {
+		return nil, ErrRootNotSet
+	}
+
+	toReturn := &Report{
+		Success: true,
+		Results: &Results{
+			Detectors: make([]Detector, 0),
+		},
+	}
+
+	for _, detector := range detectors {
+		for _, finding := range detector.Detect(root) {
+			toReturn.Results.Detectors = append(toReturn.Results.Detectors, newDetectorFromFinding(detector, finding))
+		}
+	}
+
+	return toReturn, nil
+}
+
+// newDetectorFromFinding converts the finding of the native detector into the Detector result.
+func newDetectorFromFinding(detector NativeDetector, finding Finding) Detector {
+	toReturn := Detector{
+		Elements:    finding.Elements,
+		Description: finding.Description,
+		Markdown:    finding.Description,
+		Check:       detector.Check(),
+		Impact:      detector.Impact().String(),
+		Confidence:  detector.Confidence().String(),
+		ID:          common.Bytes2Hex(utils.Keccak256([]byte(detector.Check() + finding.Description))),
+	}
+
+	if len(finding.Elements) > 0 {
+		mapping := finding.Elements[0].SourceMapping
+		toReturn.FirstMarkdownElement = fmt.Sprintf("%s#L%d", mapping.FilenameRelative, firstLine(mapping))
+	}
+
+	return toReturn
+}
+
+// newElement creates the report element of the given type from the AST source location.
+// Parent of the element is the contract it belongs to.
+func newElement(elementType string, name string, src ast.SrcNode, contract *ir.Contract) Element {
+	toReturn := Element{
+		Type:          elementType,
+		Name:          name,
+		SourceMapping: newSourceMapping(src, contract),
+	}
+
+	if contract != nil && elementType != "contract" {
+		parent := newElement("contract", contract.GetName(), contract.GetSrc(), contract)
+		toReturn.TypeSpecificFields.Parent = &parent
+	}
+
+	return toReturn
+}
+
+// newSourceMapping creates the source mapping from the AST source location. Columns are 1-based, same as in Slither.
+func newSourceMapping(src ast.SrcNode, contract *ir.Contract) SourceMapping {
+	toReturn := SourceMapping{
+		Start:          int(src.GetStart()),
+		Length:         int(src.GetLength()),
+		Lines:          []int32{int32(src.GetLine())},
+		StartingColumn: int(src.GetColumn()) + 1,
+	}
+
+	if contract != nil {
+		toReturn.FilenameRelative = contract.GetAbsolutePath()
+		toReturn.FilenameAbsolute = contract.GetAbsolutePath()
+		toReturn.FilenameShort = filepath.Base(contract.GetAbsolutePath())
+	}
+
+	return toReturn
+}
+
+// firstLine returns the first line of the source mapping.
+func firstLine(mapping SourceMapping) int32 {
+	if len(mapping.Lines) == 0 {
+		return 0
+	}
+	return mapping.Lines[0]
+}
+
+// location returns the human readable location of the element, e.g. Token.sol#12.
+func location(element Element) string {
+	return fmt.Sprintf("%s#%d", element.SourceMapping.FilenameShort, firstLine(element.SourceMapping))
+}
@@ -0,0 +1,582 @@
+package audit
+
+import (
+	"fmt"
+	"sort"
+
+	ast_pb "github.com/unpackdev/protos/dist/go/ast"
+	"github.com/unpackdev/solgo/ast"
+	"github.com/unpackdev/solgo/ir"
+)
+
+// DefaultNativeDetectors returns the native detectors shipped with the package. Identifiers of
+// the checks are matching the ones used by Slither, so the reports can be consumed the same way.
+func DefaultNativeDetectors() []NativeDetector {
+	return []NativeDetector{
+		&ReentrancyDetector{SendsValue: true},
+		&ReentrancyDetector{SendsValue: false},
+		&TxOriginDetector{},
+		&UncheckedLowLevelDetector{},
+		&UncheckedSendDetector{},
+		&ControlledDelegatecallDetector{},
+		&SuicidalDetector{},
+		&ArbitrarySendDetector{},
+		&StateShadowingDetector{},
+		&LocalShadowingDetector{},
+	}
+}
+
+// ReentrancyDetector detects state variables written after the external call, which allows
+// the called contract to re-enter the function while the state is not yet updated.
+type ReentrancyDetector struct {
+	SendsValue bool // SendsValue limits the check to the external calls transferring ether.
+}
+
+// Check returns the identifier of the check.
+func (d *ReentrancyDetector) Check() string {
+	if d.SendsValue {
+		return "reentrancy-eth"
+	}
+	return "reentrancy-no-eth"
+}
+
+// Impact returns the severity of the issues found by the check.
+func (d *ReentrancyDetector) Impact() ImpactLevel {
+	if d.SendsValue {
+		return ImpactHigh
+	}
+	return ImpactMedium
+}
+
+// Confidence returns the confidence of the check.
+func (d *ReentrancyDetector) Confidence() ConfidenceLevel {
+	return ConfidenceMedium
+}
+
+// Detect searches for the state variables written after the external call.
+func (d *ReentrancyDetector) Detect(root *ir.RootSourceUnit) []Finding {
+	toReturn := make([]Finding, 0)
+
+	for _, contract := range root.GetContracts() {
+		stateVariables := stateVariableNames(root, contract)
+
+		for _, function := range contract.GetFunctions() {
+			if isReadOnly(function) || hasReentrancyGuard(function) {
+				continue
+			}
+
+			locals := localVariableNames(function)
+
+			var externalCall *memberCall
+			written := make([]string, 0)
+			writes := make([]Element, 0)
+
+			walkFunction(function, func(node ast.Node[ast.NodeType]) bool {
+				if call := getMemberCall(node); call != nil && externalCall == nil {
+					// Transfer and send are forwarding only the gas stipend which is not enough to re-enter.
+					lowLevel := call.isLowLevel(root) && call.access.MemberName != "transfer" && call.access.MemberName != "send"
+					if (lowLevel || call.isHighLevel(root)) && call.sendsValue() == d.SendsValue {
+						externalCall = call
+					}
+					return true
+				}
+
+				if externalCall == nil || node.GetSrc().GetStart() <= externalCall.call.GetSrc().GetEnd() {
+					return true
+				}
+
+				name := stateWrite(node)
+				if variable, ok := stateVariables[name]; ok && !locals[name] && !variable.IsConstant() {
+					written = append(written, name)
+					writes = append(writes, newElement("node", name, node.GetSrc(), contract))
+				}
+				return true
+			})
+
+			if externalCall == nil || len(written) == 0 {
+				continue
+			}
+
+			functionElement := newFunctionElement(contract, function)
+			elements := append([]Element{functionElement, newElement("node", externalCall.access.MemberName, externalCall.call.GetSrc(), contract)}, writes...)
+
+			toReturn = append(toReturn, Finding{
+				Description: fmt.Sprintf(
+					"Reentrancy in %s (%s): state variables written after the external call: %v\n",
+					functionName(contract, function), location(functionElement), uniqueNames(written),
+				),
+				Elements: elements,
+			})
+		}
+	}
+
+	return toReturn
+}
+
+// stateWrite returns the name of the variable written by the node, or an empty string if the node does not write.
+func stateWrite(node ast.Node[ast.NodeType]) string {
+	switch expression := node.(type) {
+	case *ast.Assignment:
+		return baseIdentifierName(expression.LeftExpression)
+	case *ast.UnaryPrefix:
+		switch expression.Operator {
+		case ast_pb.Operator_INCREMENT, ast_pb.Operator_DECREMENT, ast_pb.Operator_O_DEFAULT:
+			return baseIdentifierName(expression.Expression)
+		}
+	case *ast.UnarySuffix:
+		switch expression.Operator {
+		case ast_pb.Operator_INCREMENT, ast_pb.Operator_DECREMENT:
+			return baseIdentifierName(expression.Expression)
+		}
+	}
+	return ""
+}
+
+// uniqueNames returns sorted names without duplicates.
+func uniqueNames(names []string) []string {
+	seen := make(map[string]bool)
+	toReturn := make([]string, 0, len(names))
+	for _, name := range names {
+		if !seen[name] {
+			seen[name] = true
+			toReturn = append(toReturn, name)
+		}
+	}
+	sort.Strings(toReturn)
+	return toReturn
+}
+
+// TxOriginDetector detects authorization based on `tx.origin`, which can be abused by the phishing contract.
+type TxOriginDetector struct{}
+
+// Check returns the identifier of the check.
+func (d *TxOriginDetector) Check() string {
+	return "tx-origin"
+}
+
+// Impact returns the severity of the issues found by the check.
+func (d *TxOriginDetector) Impact() ImpactLevel {
+	return ImpactMedium
+}
+
+// Confidence returns the confidence of the check.
+func (d *TxOriginDetector) Confidence() ConfidenceLevel {
+	return ConfidenceMedium
+}
+
+// Detect searches for the comparisons with `tx.origin`. Comparison of `tx.origin` with `msg.sender`
+// is used to prevent calls from contracts and is not reported.
+func (d *TxOriginDetector) Detect(root *ir.RootSourceUnit) []Finding {
+	toReturn := make([]Finding, 0)
+
+	for _, contract := range root.GetContracts() {
+		for _, function := range contract.GetFunctions() {
+			walkFunction(function, func(node ast.Node[ast.NodeType]) bool {
+				operation, ok := node.(*ast.BinaryOperation)
+				if !ok || (operation.Operator != ast_pb.Operator_EQUAL && operation.Operator != ast_pb.Operator_NOT_EQUAL) {
+					return true
+				}
+
+				left, right := operation.LeftExpression, operation.RightExpression
+				if isMemberOf(right, "tx", "origin") {
+					left, right = right, left
+				}
+
+				if !isMemberOf(left, "tx", "origin") || isMsgSender(right) {
+					return true
+				}
+
+				functionElement := newFunctionElement(contract, function)
+				toReturn = append(toReturn, Finding{
+					Description: fmt.Sprintf(
+						"%s (%s) uses tx.origin for authorization\n",
+						functionName(contract, function), location(functionElement),
+					),
+					Elements: []Element{functionElement, newElement("node", "tx.origin", operation.GetSrc(), contract)},
+				})
+				return true
+			})
+		}
+	}
+
+	return toReturn
+}
+
+// UncheckedLowLevelDetector detects low-level calls whose return value is ignored.
+type UncheckedLowLevelDetector struct{}
+
+// Check returns the identifier of the check.
+func (d *UncheckedLowLevelDetector) Check() string {
+	return "unchecked-lowlevel"
+}
+
+// Impact returns the severity of the issues found by the check.
+func (d *UncheckedLowLevelDetector) Impact() ImpactLevel {
+	return ImpactMedium
+}
+
+// Confidence returns the confidence of the check.
+func (d *UncheckedLowLevelDetector) Confidence() ConfidenceLevel {
+	return ConfidenceMedium
+}
+
+// Detect searches for the low-level calls used as statements.
+func (d *UncheckedLowLevelDetector) Detect(root *ir.RootSourceUnit) []Finding {
+	return detectUncheckedCalls(root, map[string]bool{"call": true, "delegatecall": true, "staticcall": true}, "ignores return value by")
+}
+
+// UncheckedSendDetector detects `send` calls whose return value is ignored.
+type UncheckedSendDetector struct{}
+
+// Check returns the identifier of the check.
+func (d *UncheckedSendDetector) Check() string {
+	return "unchecked-send"
+}
+
+// Impact returns the severity of the issues found by the check.
+func (d *UncheckedSendDetector) Impact() ImpactLevel {
+	return ImpactMedium
+}
+
+// Confidence returns the confidence of the check.
+func (d *UncheckedSendDetector) Confidence() ConfidenceLevel {
+	return ConfidenceMedium
+}
+
+// Detect searches for the `send` calls used as statements.
+func (d *UncheckedSendDetector) Detect(root *ir.RootSourceUnit) []Finding {
+	return detectUncheckedCalls(root, map[string]bool{"send": true}, "ignores return value by")
+}
+
+// detectUncheckedCalls searches for the calls of the given members used as statements, meaning
+// their return value is not checked.
+func detectUncheckedCalls(root *ir.RootSourceUnit, members map[string]bool, message string) []Finding {
+	toReturn := make([]Finding, 0)
+
+	for _, contract := range root.GetContracts() {
+		for _, function := range contract.GetFunctions() {
+			walkFunction(function, func(node ast.Node[ast.NodeType]) bool {
+				body, ok := node.(*ast.BodyNode)
+				if !ok {
+					return true
+				}
+
+				for _, statement := range body.GetStatements() {
+					call := getMemberCall(statement)
+					if call == nil || !members[call.access.MemberName] || !call.isLowLevel(root) {
+						continue
+					}
+
+					functionElement := newFunctionElement(contract, function)
+					toReturn = append(toReturn, Finding{
+						Description: fmt.Sprintf(
+							"%s (%s) %s %s\n",
+							functionName(contract, function), location(functionElement), message, call.access.MemberName,
+						),
+						Elements: []Element{functionElement, newElement("node", call.access.MemberName, call.call.GetSrc(), contract)},
+					})
+				}
+				return true
+			})
+		}
+	}
+
+	return toReturn
+}
+
+// ControlledDelegatecallDetector detects delegatecall to the address or with the data controlled
+// by the caller of the unprotected function.
+type ControlledDelegatecallDetector struct{}
+
+// Check returns the identifier of the check.
+func (d *ControlledDelegatecallDetector) Check() string {
+	return "controlled-delegatecall"
+}
+
+// Impact returns the severity of the issues found by the check.
+func (d *ControlledDelegatecallDetector) Impact() ImpactLevel {
+	return ImpactHigh
+}
+
+// Confidence returns the confidence of the check.
+func (d *ControlledDelegatecallDetector) Confidence() ConfidenceLevel {
+	return ConfidenceMedium
+}
+
+// Detect searches for the delegatecall using the function parameters.
+func (d *ControlledDelegatecallDetector) Detect(root *ir.RootSourceUnit) []Finding {
+	toReturn := make([]Finding, 0)
+
+	for _, contract := range root.GetContracts() {
+		for _, function := range contract.GetFunctions() {
+			if !isExternallyCallable(function) || isProtected(function) {
+				continue
+			}
+
+			parameters := parameterNames(function)
+			usesParameter := func(node ast.Node[ast.NodeType]) bool {
+				return parameters[identifierName(node)]
+			}
+
+			walkFunction(function, func(node ast.Node[ast.NodeType]) bool {
+				call := getMemberCall(node)
+				if call == nil || call.access.MemberName != "delegatecall" {
+					return true
+				}
+
+				controlled := containsNode(call.access.Expression, usesParameter)
+				for _, argument := range call.call.Arguments {
+					controlled = controlled || containsNode(argument, usesParameter)
+				}
+
+				if controlled {
+					functionElement := newFunctionElement(contract, function)
+					toReturn = append(toReturn, Finding{
+						Description: fmt.Sprintf(
+							"%s (%s) uses delegatecall to a input-controlled function id\n",
+							functionName(contract, function), location(functionElement),
+						),
+						Elements: []Element{functionElement, newElement("node", "delegatecall", call.call.GetSrc(), contract)},
+					})
+				}
+				return true
+			})
+		}
+	}
+
+	return toReturn
+}
+
+// SuicidalDetector detects unprotected functions destroying the contract.
+type SuicidalDetector struct{}
+
+// Check returns the identifier of the check.
+func (d *SuicidalDetector) Check() string {
+	return "suicidal"
+}
+
+// Impact returns the severity of the issues found by the check.
+func (d *SuicidalDetector) Impact() ImpactLevel {
+	return ImpactHigh
+}
+
+// Confidence returns the confidence of the check.
+func (d *SuicidalDetector) Confidence() ConfidenceLevel {
+	return ConfidenceHigh
+}
+
+// Detect searches for the selfdestruct in the functions anyone can call.
+func (d *SuicidalDetector) Detect(root *ir.RootSourceUnit) []Finding {
+	toReturn := make([]Finding, 0)
+
+	for _, contract := range root.GetContracts() {
+		for _, function := range contract.GetFunctions() {
+			if !isExternallyCallable(function) || isProtected(function) {
+				continue
+			}
+
+			walkFunction(function, func(node ast.Node[ast.NodeType]) bool {
+				call, ok := node.(*ast.FunctionCall)
+				if !ok {
+					return true
+				}
+
+				if name := identifierName(call.Expression); name == "selfdestruct" || name == "suicide" {
+					functionElement := newFunctionElement(contract, function)
+					toReturn = append(toReturn, Finding{
+						Description: fmt.Sprintf(
+							"%s (%s) allows anyone to destruct the contract\n",
+							functionName(contract, function), location(functionElement),
+						),
+						Elements: []Element{functionElement, newElement("node", name, call.GetSrc(), contract)},
+					})
+				}
+				return true
+			})
+		}
+	}
+
+	return toReturn
+}
+
+// ArbitrarySendDetector detects unprotected functions sending ether to the arbitrary address.
+type ArbitrarySendDetector struct{}
+
+// Check returns the identifier of the check.
+func (d *ArbitrarySendDetector) Check() string {
+	return "arbitrary-send-eth"
+}
+
+// Impact returns the severity of the issues found by the check.
+func (d *ArbitrarySendDetector) Impact() ImpactLevel {
+	return ImpactHigh
+}
+
+// Confidence returns the confidence of the check.
+func (d *ArbitrarySendDetector) Confidence() ConfidenceLevel {
+	return ConfidenceMedium
+}
+
+// Detect searches for the ether transfers to the destination which is neither `msg.sender` nor
+// the state variable, in the functions anyone can call.
+func (d *ArbitrarySendDetector) Detect(root *ir.RootSourceUnit) []Finding {
+	toReturn := make([]Finding, 0)
+
+	for _, contract := range root.GetContracts() {
+		stateVariables := stateVariableNames(root, contract)
+
+		for _, function := range contract.GetFunctions() {
+			if !isExternallyCallable(function) || isProtected(function) {
+				continue
+			}
+
+			locals := localVariableNames(function)
+
+			walkFunction(function, func(node ast.Node[ast.NodeType]) bool {
+				call := getMemberCall(node)
+				if call == nil || !call.isLowLevel(root) || !call.sendsValue() {
+					return true
+				}
+
+				destination := call.access.Expression
+				if isMsgSender(destination) {
+					return true
+				}
+
+				if name := destinationName(destination); name != "" && !locals[name] {
+					if _, ok := stateVariables[name]; ok {
+						return true
+					}
+				}
+
+				functionElement := newFunctionElement(contract, function)
+				toReturn = append(toReturn, Finding{
+					Description: fmt.Sprintf(
+						"%s (%s) sends eth to arbitrary user\n",
+						functionName(contract, function), location(functionElement),
+					),
+					Elements: []Element{functionElement, newElement("node", call.access.MemberName, call.call.GetSrc(), contract)},
+				})
+				return true
+			})
+		}
+	}
+
+	return toReturn
+}
+
+// destinationName returns the name of the variable holding the destination address, unwrapping
+// the payable conversion.
+func destinationName(node ast.Node[ast.NodeType]) string {
+	if call, ok := node.(*ast.FunctionCall); ok && !isNilNode(node) {
+		if name := identifierName(call.Expression); (name == "payable" || name == "address") && len(call.Arguments) == 1 {
+			return destinationName(call.Arguments[0])
+		}
+		return ""
+	}
+	return baseIdentifierName(node)
+}
+
+// StateShadowingDetector detects state variables shadowing the state variables of the base contracts.
+type StateShadowingDetector struct{}
+
+// Check returns the identifier of the check.
+func (d *StateShadowingDetector) Check() string {
+	return "shadowing-state"
+}
+
+// Impact returns the severity of the issues found by the check.
+func (d *StateShadowingDetector) Impact() ImpactLevel {
+	return ImpactHigh
+}
+
+// Confidence returns the confidence of the check.
+func (d *StateShadowingDetector) Confidence() ConfidenceLevel {
+	return ConfidenceHigh
+}
+
+// Detect searches for the state variables declared with the same name in the base contracts.
+func (d *StateShadowingDetector) Detect(root *ir.RootSourceUnit) []Finding {
+	toReturn := make([]Finding, 0)
+
+	for _, contract := range root.GetContracts() {
+		bases := linearizedContracts(root, contract)[1:]
+
+		for _, variable := range contract.GetStateVariables() {
+			for _, base := range bases {
+				var shadowed *ir.StateVariable
+				for _, baseVariable := range base.GetStateVariables() {
+					if baseVariable.GetName() == variable.GetName() {
+						shadowed = baseVariable
+					}
+				}
+
+				if shadowed == nil {
+					continue
+				}
+
+				variableElement := newElement("variable", variable.GetName(), variable.GetSrc(), contract)
+				toReturn = append(toReturn, Finding{
+					Description: fmt.Sprintf(
+						"%s.%s (%s) shadows %s.%s\n",
+						contract.GetName(), variable.GetName(), location(variableElement), base.GetName(), shadowed.GetName(),
+					),
+					Elements: []Element{variableElement, newElement("variable", shadowed.GetName(), shadowed.GetSrc(), base)},
+				})
+				break
+			}
+		}
+	}
+
+	return toReturn
+}
+
+// LocalShadowingDetector detects function parameters and local variables shadowing the state variables.
+type LocalShadowingDetector struct{}
+
+// Check returns the identifier of the check.
+func (d *LocalShadowingDetector) Check() string {
+	return "shadowing-local"
+}
+
+// Impact returns the severity of the issues found by the check.
+func (d *LocalShadowingDetector) Impact() ImpactLevel {
+	return ImpactLow
+}
+
+// Confidence returns the confidence of the check.
+func (d *LocalShadowingDetector) Confidence() ConfidenceLevel {
+	return ConfidenceHigh
+}
+
+// Detect searches for the parameters and local variables named the same as the state variables.
+func (d *LocalShadowingDetector) Detect(root *ir.RootSourceUnit) []Finding {
+	toReturn := make([]Finding, 0)
+
+	for _, contract := range root.GetContracts() {
+		stateVariables := stateVariableNames(root, contract)
+
+		for _, function := range contract.GetFunctions() {
+			names := make([]string, 0)
+			for name := range localVariableNames(function) {
+				if _, ok := stateVariables[name]; ok {
+					names = append(names, name)
+				}
+			}
+
+			for _, name := range uniqueNames(names) {
+				functionElement := newFunctionElement(contract, function)
+				variable := stateVariables[name]
+				toReturn = append(toReturn, Finding{
+					Description: fmt.Sprintf(
+						"%s.%s (%s) shadows state variable %s\n",
+						functionName(contract, function), name, location(functionElement), variable.GetName(),
+					),
+					Elements: []Element{functionElement, newElement("variable", variable.GetName(), variable.GetSrc(), contract)},
+				})
+			}
+		}
+	}
+
+	return toReturn
+}
@@ -0,0 +1,343 @@
+package audit
+
+import (
+	"reflect"
+	"strings"
+
+	ast_pb "github.com/unpackdev/protos/dist/go/ast"
+	"github.com/unpackdev/solgo/ast"
+	"github.com/unpackdev/solgo/ir"
+)
+
+// lowLevelCalls are the members of the address type performing the low-level call.
+var lowLevelCalls = map[string]bool{
+	"call":         true,
+	"delegatecall": true,
+	"staticcall":   true,
+	"send":         true,
+	"transfer":     true,
+}
+
+// isNilNode reports whether the node is nil, including the typed nil pointers the AST holds.
+func isNilNode(node ast.Node[ast.NodeType]) bool {
+	if node == nil {
+		return true
+	}
+	value := reflect.ValueOf(node)
+	return value.Kind() == reflect.Ptr && value.IsNil()
+}
+
+// walkNode calls visit for the node and all of its descendants. Children of the node are not
+// visited when visit returns false.
+func walkNode(node ast.Node[ast.NodeType], visit func(node ast.Node[ast.NodeType]) bool) {
+	if isNilNode(node) || !visit(node) {
+		return
+	}
+
+	for _, child := range node.GetNodes() {
+		walkNode(child, visit)
+	}
+}
+
+// walkFunction calls visit for all of the nodes of the function body.
+func walkFunction(function *ir.Function, visit func(node ast.Node[ast.NodeType]) bool) {
+	if function.GetAST() == nil || function.GetAST().GetBody() == nil {
+		return
+	}
+
+	// Assignment exposes the children of the right expression twice, so the nodes are deduplicated.
+	visited := make(map[int64]bool)
+	walkNode(function.GetAST().GetBody(), func(node ast.Node[ast.NodeType]) bool {
+		if visited[node.GetId()] {
+			return false
+		}
+		visited[node.GetId()] = true
+		return visit(node)
+	})
+}
+
+// identifierName returns the name of the identifier or an empty string if the node is not an identifier.
+func identifierName(node ast.Node[ast.NodeType]) string {
+	if primary, ok := node.(*ast.PrimaryExpression); ok && !isNilNode(node) {
+		return primary.GetName()
+	}
+	return ""
+}
+
+// baseIdentifierName returns the name of the variable accessed by the expression, e.g. `balances`
+// for `balances[msg.sender]` or `s` for `s.owner`.
+func baseIdentifierName(node ast.Node[ast.NodeType]) string {
+	for !isNilNode(node) {
+		switch expression := node.(type) {
+		case *ast.PrimaryExpression:
+			return expression.GetName()
+		case *ast.IndexAccess:
+			node = expression.BaseExpression
+		case *ast.MemberAccessExpression:
+			node = expression.Expression
+		case *ast.TupleExpression:
+			if len(expression.Components) != 1 {
+				return ""
+			}
+			node = expression.Components[0]
+		default:
+			return ""
+		}
+	}
+	return ""
+}
+
+// isMemberOf reports whether the node is the member access of the given identifier, e.g. `msg.sender`.
+func isMemberOf(node ast.Node[ast.NodeType], identifier string, member string) bool {
+	access, ok := node.(*ast.MemberAccessExpression)
+	if !ok || isNilNode(node) {
+		return false
+	}
+	return access.MemberName == member && identifierName(access.Expression) == identifier
+}
+
+// isMsgSender reports whether the node is `msg.sender`, `_msgSender()` or their payable conversion.
+func isMsgSender(node ast.Node[ast.NodeType]) bool {
+	if isMemberOf(node, "msg", "sender") {
+		return true
+	}
+
+	call, ok := node.(*ast.FunctionCall)
+	if !ok || isNilNode(node) {
+		return false
+	}
+
+	switch identifierName(call.Expression) {
+	case "_msgSender":
+		return true
+	case "payable", "address":
+		return len(call.Arguments) == 1 && isMsgSender(call.Arguments[0])
+	}
+
+	return false
+}
+
+// containsNode reports whether the node or any of its descendants satisfies the predicate.
+func containsNode(node ast.Node[ast.NodeType], predicate func(node ast.Node[ast.NodeType]) bool) bool {
+	found := false
+	walkNode(node, func(child ast.Node[ast.NodeType]) bool {
+		if found {
+			return false
+		}
+		if predicate(child) {
+			found = true
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// memberCall holds the parts of the call to the member, e.g. `to.call{value: amount}("")`.
+type memberCall struct {
+	call    *ast.FunctionCall
+	access  *ast.MemberAccessExpression
+	options *ast.FunctionCallOption
+}
+
+// getMemberCall returns the member call or nil if the node is not a call to the member.
+func getMemberCall(node ast.Node[ast.NodeType]) *memberCall {
+	call, ok := node.(*ast.FunctionCall)
+	if !ok || isNilNode(node) {
+		return nil
+	}
+
+	toReturn := &memberCall{call: call}
+	expression := call.Expression
+	if options, ok := expression.(*ast.FunctionCallOption); ok && !isNilNode(expression) {
+		toReturn.options = options
+		expression = options.Expression
+	}
+
+	access, ok := expression.(*ast.MemberAccessExpression)
+	if !ok || isNilNode(expression) {
+		return nil
+	}
+	toReturn.access = access
+
+	return toReturn
+}
+
+// isLowLevel reports whether the call is the low-level call of the address.
+func (c *memberCall) isLowLevel(root *ir.RootSourceUnit) bool {
+	return lowLevelCalls[c.access.MemberName] && !c.isHighLevel(root)
+}
+
+// isHighLevel reports whether the call is the call of the function of another contract, e.g. `token.transfer(to, amount)`.
+func (c *memberCall) isHighLevel(root *ir.RootSourceUnit) bool {
+	target := c.access.Expression
+	if isNilNode(target) {
+		return false
+	}
+
+	// Explicit conversion of the address to the contract, e.g. IERC20(token).transfer(to, amount).
+	if conversion, ok := target.(*ast.FunctionCall); ok {
+		name := identifierName(conversion.Expression)
+		return name != "" && root.GetContractByName(name) != nil
+	}
+
+	if description := target.GetTypeDescription(); description != nil {
+		return strings.HasPrefix(description.GetString(), "contract ")
+	}
+
+	return false
+}
+
+// sendsValue reports whether the call transfers ether, e.g. `to.transfer(amount)` or `to.call{value: amount}("")`.
+func (c *memberCall) sendsValue() bool {
+	switch c.access.MemberName {
+	case "transfer", "send":
+		return len(c.call.Arguments) == 1
+	}
+
+	if c.options != nil {
+		for _, name := range c.options.GetNames() {
+			if name == "value" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// stateVariableNames returns the names of the state variables of the contract, including the inherited ones.
+func stateVariableNames(root *ir.RootSourceUnit, contract *ir.Contract) map[string]*ir.StateVariable {
+	toReturn := make(map[string]*ir.StateVariable)
+	for _, base := range linearizedContracts(root, contract) {
+		for _, variable := range base.GetStateVariables() {
+			if _, ok := toReturn[variable.GetName()]; !ok {
+				toReturn[variable.GetName()] = variable
+			}
+		}
+	}
+	return toReturn
+}
+
+// linearizedContracts returns the contract followed by all of its base contracts found in the root.
+func linearizedContracts(root *ir.RootSourceUnit, contract *ir.Contract) []*ir.Contract {
+	toReturn := []*ir.Contract{contract}
+	visited := map[string]bool{contract.GetName(): true}
+
+	for i := 0; i < len(toReturn); i++ {
+		for _, baseContract := range toReturn[i].GetBaseContracts() {
+			if baseContract.GetBaseName() == nil || visited[baseContract.GetBaseName().Name] {
+				continue
+			}
+			visited[baseContract.GetBaseName().Name] = true
+
+			if base := root.GetContractByName(baseContract.GetBaseName().Name); base != nil {
+				toReturn = append(toReturn, base)
+			}
+		}
+	}
+
+	return toReturn
+}
+
+// localVariableNames returns the names of the parameters and local variables of the function.
+func localVariableNames(function *ir.Function) map[string]bool {
+	toReturn := make(map[string]bool)
+	for _, parameter := range function.GetParameters() {
+		toReturn[parameter.GetName()] = true
+	}
+	for _, parameter := range function.GetReturnStatements() {
+		toReturn[parameter.GetName()] = true
+	}
+
+	walkFunction(function, func(node ast.Node[ast.NodeType]) bool {
+		if declaration, ok := node.(*ast.VariableDeclaration); ok {
+			for _, variable := range declaration.GetDeclarations() {
+				toReturn[variable.GetName()] = true
+			}
+		}
+		return true
+	})
+
+	delete(toReturn, "")
+	return toReturn
+}
+
+// parameterNames returns the names of the parameters of the function.
+func parameterNames(function *ir.Function) map[string]bool {
+	toReturn := make(map[string]bool)
+	for _, parameter := range function.GetParameters() {
+		if parameter.GetName() != "" {
+			toReturn[parameter.GetName()] = true
+		}
+	}
+	return toReturn
+}
+
+// isExternallyCallable reports whether the function can be called by anyone from outside of the contract.
+func isExternallyCallable(function *ir.Function) bool {
+	return function.GetVisibility() == ast_pb.Visibility_PUBLIC || function.GetVisibility() == ast_pb.Visibility_EXTERNAL
+}
+
+// isReadOnly reports whether the function is not able to modify the state.
+func isReadOnly(function *ir.Function) bool {
+	return function.GetStateMutability() == ast_pb.Mutability_VIEW || function.GetStateMutability() == ast_pb.Mutability_PURE
+}
+
+// hasReentrancyGuard reports whether the function is guarded by the reentrancy guard modifier.
+func hasReentrancyGuard(function *ir.Function) bool {
+	for _, modifier := range function.GetModifiers() {
+		name := strings.ToLower(modifier.GetName())
+		if strings.Contains(name, "reentran") || name == "lock" || name == "mutex" {
+			return true
+		}
+	}
+	return false
+}
+
+// isProtected reports whether the function is guarded by the access control modifier or by
+// the `msg.sender` check in the require statement or the if condition.
+func isProtected(function *ir.Function) bool {
+	for _, modifier := range function.GetModifiers() {
+		name := strings.ToLower(modifier.GetName())
+		if !strings.Contains(name, "reentran") && name != "lock" && name != "mutex" && name != "whennotpaused" {
+			return true
+		}
+	}
+
+	protected := false
+	walkFunction(function, func(node ast.Node[ast.NodeType]) bool {
+		if protected {
+			return false
+		}
+
+		var condition ast.Node[ast.NodeType]
+		switch statement := node.(type) {
+		case *ast.IfStatement:
+			condition = statement.GetCondition()
+		case *ast.FunctionCall:
+			if name := identifierName(statement.Expression); (name == "require" || name == "assert") && len(statement.Arguments) > 0 {
+				condition = statement.Arguments[0]
+			}
+		}
+
+		if !isNilNode(condition) && containsNode(condition, isMsgSender) {
+			protected = true
+		}
+		return true
+	})
+
+	return protected
+}
+
+// functionName returns the name of the function as used in the descriptions, e.g. Token.withdraw(uint256).
+func functionName(contract *ir.Contract, function *ir.Function) string {
+	return contract.GetName() + "." + function.GetSignatureRaw()
+}
+
+// newFunctionElement creates the report element of the function.
+func newFunctionElement(contract *ir.Contract, function *ir.Function) Element {
+	toReturn := newElement("function", function.GetName(), function.GetSrc(), contract)
+	toReturn.Signature = function.GetSignatureRaw()
+	return toReturn
+}
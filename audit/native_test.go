@@ -0,0 +1,132 @@
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unpackdev/solgo"
+	"github.com/unpackdev/solgo/ir"
+)
+
+func TestNativeAnalyzer(t *testing.T) {
+	content := `// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+contract Base {
+    address public owner;
+}
+
+contract Vault is Base {
+    address public owner;
+    mapping(address => uint256) public balances;
+    uint256 public total;
+
+    function deposit() external payable {
+        balances[msg.sender] += msg.value;
+    }
+
+    function withdraw(uint256 amount) external {
+        require(balances[msg.sender] >= amount);
+        (bool success, ) = msg.sender.call{value: amount}("");
+        require(success);
+        balances[msg.sender] -= amount;
+    }
+
+    function safeWithdraw(uint256 amount) external {
+        require(balances[msg.sender] >= amount);
+        balances[msg.sender] -= amount;
+        (bool success, ) = msg.sender.call{value: amount}("");
+        require(success);
+    }
+
+    function transferTo(address to, uint256 amount) external {
+        require(tx.origin == owner);
+        payable(to).transfer(amount);
+    }
+
+    function ping(address target) external {
+        target.call("");
+    }
+
+    function pay(address payable to) external {
+        to.send(1);
+    }
+
+    function forward(address implementation, bytes memory data) external {
+        implementation.delegatecall(data);
+    }
+
+    function kill() external {
+        selfdestruct(payable(msg.sender));
+    }
+
+    function setTotal(uint256 total) external {
+        require(msg.sender == owner);
+        payable(owner).transfer(total);
+    }
+}
+`
+
+	sources := &solgo.Sources{
+		SourceUnits: []*solgo.SourceUnit{
+			{
+				Name:    "Vault",
+				Path:    "Vault.sol",
+				Content: content,
+			},
+		},
+		EntrySourceUnitName: "Vault",
+	}
+
+	builder, err := ir.NewBuilderFromSources(context.TODO(), sources)
+	require.NoError(t, err)
+	require.Empty(t, builder.Parse())
+	require.NoError(t, builder.Build())
+
+	report, err := NewNativeAnalyzer().Analyze(builder.GetRoot())
+	require.NoError(t, err)
+	require.NotNil(t, report)
+	assert.True(t, report.HasIssues())
+
+	testCases := []struct {
+		check     string
+		functions []string
+	}{
+		{check: "reentrancy-eth", functions: []string{"withdraw"}},
+		{check: "reentrancy-no-eth", functions: []string{}},
+		{check: "tx-origin", functions: []string{"transferTo"}},
+		{check: "unchecked-lowlevel", functions: []string{"ping", "forward"}},
+		{check: "unchecked-send", functions: []string{"pay"}},
+		{check: "controlled-delegatecall", functions: []string{"forward"}},
+		{check: "suicidal", functions: []string{"kill"}},
+		{check: "arbitrary-send-eth", functions: []string{"transferTo", "pay"}},
+		{check: "shadowing-state", functions: []string{}},
+		{check: "shadowing-local", functions: []string{"setTotal"}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.check, func(t *testing.T) {
+			functions := make([]string, 0)
+			for _, detector := range report.DetectorsByCheck(testCase.check) {
+				require.NotEmpty(t, detector.Elements)
+				assert.NotEmpty(t, detector.ID)
+				assert.NotEmpty(t, detector.Description)
+				if detector.Elements[0].Type == "function" {
+					functions = append(functions, detector.Elements[0].Name)
+				}
+			}
+			assert.ElementsMatch(t, testCase.functions, functions)
+		})
+	}
+
+	shadowing := report.DetectorsByCheck("shadowing-state")
+	require.Len(t, shadowing, 1)
+	assert.Equal(t, "owner", shadowing[0].Elements[0].Name)
+	assert.Equal(t, ImpactHigh.String(), shadowing[0].Impact)
+	assert.Equal(t, ConfidenceHigh.String(), shadowing[0].Confidence)
+
+	_, err = NewNativeAnalyzer().Analyze(nil)
+	assert.ErrorIs(t, err, ErrRootNotSet)
+}
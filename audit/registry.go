@@ -0,0 +1,145 @@
+package audit
+
+import (
+	"sync"
+
+	"github.com/unpackdev/solgo/ir"
+)
+
+// VisitorFunc represents the function visiting the IR root and returning the findings of the detector.
+type VisitorFunc func(root *ir.RootSourceUnit) []Finding
+
+// CustomDetector represents the user defined detector, e.g. the house rule of the team, registered
+// with the ID of the check, its impact, confidence and the visitor over the IR.
+type CustomDetector struct {
+	check      string          // Identifier of the check, e.g. "no-timestamp-in-price".
+	impact     ImpactLevel     // Severity of the issues found by the check.
+	confidence ConfidenceLevel // Confidence of the issues found by the check.
+	visitor    VisitorFunc     // Visitor searching for the issues in the IR root.
+}
+
+// NewCustomDetector creates a new custom detector with the provided check identifier, impact,
+// confidence and the visitor.
+func NewCustomDetector(check string, impact ImpactLevel, confidence ConfidenceLevel, visitor VisitorFunc) *CustomDetector {
+	return &CustomDetector{
+		check:      check,
+		impact:     impact,
+		confidence: confidence,
+		visitor:    visitor,
+	}
+}
+
+// Check returns the identifier of the check.
+func (d *CustomDetector) Check() string {
+	return d.check
+}
+
+// Impact returns the severity of the issues found by the check.
+func (d *CustomDetector) Impact() ImpactLevel {
+	return d.impact
+}
+
+// Confidence returns the confidence of the check.
+func (d *CustomDetector) Confidence() ConfidenceLevel {
+	return d.confidence
+}
+
+// Detect runs the visitor over the provided IR root.
+func (d *CustomDetector) Detect(root *ir.RootSourceUnit) []Finding {
+	return d.visitor(root)
+}
+
+// Registry holds the custom detectors registered by the users. Findings of the registered
+// detectors are merged into the audit report next to the Slither or native findings.
+type Registry struct {
+	mu        sync.RWMutex              // Guards the detectors.
+	detectors map[string]NativeDetector // Registered detectors keyed by the check.
+	order     []string                  // Checks in the order of the registration.
+}
+
+// NewRegistry initializes a new empty detector Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		detectors: make(map[string]NativeDetector),
+		order:     make([]string, 0),
+	}
+}
+
+// Register adds the detector to the registry. It returns an error if the detector is not
+// valid or if the detector with the same check is already registered.
+func (r *Registry) Register(detector NativeDetector) error {
+	if detector == nil || detector.Check() == "" || detector.Impact() == "" || detector.Confidence() == "" {
+		return ErrInvalidDetector
+	}
+
+	if custom, ok := detector.(*CustomDetector); ok && custom.visitor == nil {
+		return ErrInvalidDetector
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.detectors[detector.Check()]; ok {
+		return ErrDetectorExists
+	}
+
+	r.detectors[detector.Check()] = detector
+	r.order = append(r.order, detector.Check())
+	return nil
+}
+
+// RegisterFunc creates the custom detector from the provided visitor and adds it to the registry.
+func (r *Registry) RegisterFunc(check string, impact ImpactLevel, confidence ConfidenceLevel, visitor VisitorFunc) error {
+	return r.Register(NewCustomDetector(check, impact, confidence, visitor))
+}
+
+// Unregister removes the detector with the provided check from the registry.
+// It returns true if the detector was registered.
+func (r *Registry) Unregister(check string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.detectors[check]; !ok {
+		return false
+	}
+
+	delete(r.detectors, check)
+	for i, registered := range r.order {
+		if registered == check {
+			r.order = append(r.order[:i], r.order[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// GetDetector returns the registered detector with the provided check or nil if not found.
+func (r *Registry) GetDetector(check string) NativeDetector {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.detectors[check]
+}
+
+// GetDetectors returns the registered detectors in the order of the registration.
+func (r *Registry) GetDetectors() []NativeDetector {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	toReturn := make([]NativeDetector, 0, len(r.order))
+	for _, check := range r.order {
+		toReturn = append(toReturn, r.detectors[check])
+	}
+	return toReturn
+}
+
+// Len returns the number of the registered detectors.
+func (r *Registry) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.order)
+}
+
+// Analyze runs the registered detectors over the provided IR root and returns the report.
+func (r *Registry) Analyze(root *ir.RootSourceUnit) (*Report, error) {
+	return runDetectors(root, r.GetDetectors())
+}
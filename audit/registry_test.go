@@ -0,0 +1,138 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unpackdev/solgo"
+	"github.com/unpackdev/solgo/ast"
+	"github.com/unpackdev/solgo/ir"
+)
+
+func TestRegistry(t *testing.T) {
+	content := `// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+contract Oracle {
+    address public owner;
+    uint256 public fee;
+    uint256 public price;
+
+    modifier onlyOwner() {
+        require(msg.sender == owner);
+        _;
+    }
+
+    function updatePrice(uint256 value) external onlyOwner {
+        price = value * block.timestamp;
+    }
+
+    function setFee(uint256 value) external {
+        fee = value;
+    }
+
+    function setProtocolFee(uint256 value) external onlyOwner {
+        fee = value;
+    }
+}
+`
+
+	sources := &solgo.Sources{
+		SourceUnits: []*solgo.SourceUnit{
+			{
+				Name:    "Oracle",
+				Path:    "Oracle.sol",
+				Content: content,
+			},
+		},
+		EntrySourceUnitName: "Oracle",
+	}
+
+	builder, err := ir.NewBuilderFromSources(context.TODO(), sources)
+	require.NoError(t, err)
+	require.Empty(t, builder.Parse())
+	require.NoError(t, builder.Build())
+
+	registry := NewRegistry()
+
+	// House rule: block.timestamp must not be used in the price logic.
+	require.NoError(t, registry.RegisterFunc("timestamp-in-price", ImpactMedium, ConfidenceHigh, func(root *ir.RootSourceUnit) []Finding {
+		toReturn := make([]Finding, 0)
+		for _, contract := range root.GetContracts() {
+			for _, function := range contract.GetFunctions() {
+				walkFunction(function, func(node ast.Node[ast.NodeType]) bool {
+					if isMemberOf(node, "block", "timestamp") {
+						toReturn = append(toReturn, Finding{
+							Description: fmt.Sprintf("%s uses block.timestamp\n", functionName(contract, function)),
+							Elements:    []Element{newFunctionElement(contract, function)},
+						})
+					}
+					return true
+				})
+			}
+		}
+		return toReturn
+	}))
+
+	// House rule: fee setters must be protected by the onlyOwner modifier.
+	require.NoError(t, registry.Register(NewCustomDetector("unprotected-fee-setter", ImpactHigh, ConfidenceMedium, func(root *ir.RootSourceUnit) []Finding {
+		toReturn := make([]Finding, 0)
+		for _, contract := range root.GetContracts() {
+			for _, function := range contract.GetFunctions() {
+				if function.GetName() != "setFee" && function.GetName() != "setProtocolFee" {
+					continue
+				}
+
+				protected := false
+				for _, modifier := range function.GetModifiers() {
+					protected = protected || modifier.GetName() == "onlyOwner"
+				}
+
+				if !protected {
+					toReturn = append(toReturn, Finding{
+						Description: fmt.Sprintf("%s is missing onlyOwner\n", functionName(contract, function)),
+						Elements:    []Element{newFunctionElement(contract, function)},
+					})
+				}
+			}
+		}
+		return toReturn
+	})))
+
+	assert.ErrorIs(t, registry.RegisterFunc("timestamp-in-price", ImpactLow, ConfidenceLow, func(root *ir.RootSourceUnit) []Finding { return nil }), ErrDetectorExists)
+	assert.ErrorIs(t, registry.RegisterFunc("", ImpactLow, ConfidenceLow, func(root *ir.RootSourceUnit) []Finding { return nil }), ErrInvalidDetector)
+	assert.ErrorIs(t, registry.RegisterFunc("no-visitor", ImpactLow, ConfidenceLow, nil), ErrInvalidDetector)
+
+	require.Equal(t, 2, registry.Len())
+	assert.Equal(t, "timestamp-in-price", registry.GetDetectors()[0].Check())
+	assert.NotNil(t, registry.GetDetector("unprotected-fee-setter"))
+
+	custom, err := registry.Analyze(builder.GetRoot())
+	require.NoError(t, err)
+
+	// Merge the custom findings into the report, same as it is done with the Slither report.
+	report, err := NewNativeAnalyzer(&SuicidalDetector{}).Analyze(builder.GetRoot())
+	require.NoError(t, err)
+	assert.False(t, report.HasIssues())
+	report.Merge(custom)
+	report.Merge(custom)
+
+	require.Len(t, report.Results.Detectors, 2)
+
+	timestamp := report.DetectorsByCheck("timestamp-in-price")
+	require.Len(t, timestamp, 1)
+	assert.Equal(t, "updatePrice", timestamp[0].Elements[0].Name)
+	assert.Equal(t, ImpactMedium.String(), timestamp[0].Impact)
+
+	high := report.FilterDetectorsByImpact(ImpactHigh)
+	require.Len(t, high, 1)
+	assert.Equal(t, "unprotected-fee-setter", high[0].Check)
+	assert.Equal(t, "setFee", high[0].Elements[0].Name)
+
+	assert.True(t, registry.Unregister("timestamp-in-price"))
+	assert.False(t, registry.Unregister("timestamp-in-price"))
+	assert.Equal(t, 1, registry.Len())
+}
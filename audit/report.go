@@ -18,6 +18,21 @@ const (
 	ImpactInfo   ImpactLevel = "Informational" // Represents informational findings.
 )
 
+// ConfidenceLevel represents the confidence of the detector that the detected issue is real.
+type ConfidenceLevel string
+
+// String returns the string representation of the ConfidenceLevel.
+func (c ConfidenceLevel) String() string {
+	return string(c)
+}
+
+// Predefined confidence levels of the detected issues.
+const (
+	ConfidenceHigh   ConfidenceLevel = "High"   // Represents high confidence findings.
+	ConfidenceMedium ConfidenceLevel = "Medium" // Represents medium confidence findings.
+	ConfidenceLow    ConfidenceLevel = "Low"    // Represents low confidence findings.
+)
+
 // NewResponse parses the provided JSON data (typically from Slither) and returns
 // a structured Response object. If the data is not valid JSON or does not match
 // the expected structure, an error is returned.
@@ -115,3 +130,27 @@ func (r *Report) HighConfidenceDetectors() []Detector {
 func (r *Report) HasIssues() bool {
 	return r != nil && r.Results != nil && len(r.Results.Detectors) > 0
 }
+
+// Merge appends the detectors of the other report to this report, skipping the ones
+// already present. It is used to combine Slither findings with the native and custom ones.
+func (r *Report) Merge(other *Report) {
+	if r == nil || other == nil || other.Results == nil {
+		return
+	}
+
+	if r.Results == nil {
+		r.Results = &Results{}
+	}
+
+	seen := make(map[string]bool)
+	for _, detector := range r.Results.Detectors {
+		seen[detector.ID] = true
+	}
+
+	for _, detector := range other.Results.Detectors {
+		if !seen[detector.ID] {
+			seen[detector.ID] = true
+			r.Results.Detectors = append(r.Results.Detectors, detector)
+		}
+	}
+}
@@ -0,0 +1,375 @@
+package bytecode
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/unpackdev/solgo/opcode"
+)
+
+// Creation represents contract creation input split into the code executed on deployment, the runtime
+// code it deploys and the data appended after it.
+//
+// The split does not require an ABI or the compiled bytecode. The runtime code is located by tracking the
+// constant arguments of the CODECOPY whose copied code is then returned by the init code, which is how solc,
+// both with the legacy and the IR pipeline, deploys the runtime code.
+type Creation struct {
+	input                []byte       // The raw contract creation input
+	InitCode             []byte       `json:"init_code"`             // Code executed on deployment, preceding the runtime code
+	RuntimeCode          []byte       `json:"runtime_code"`          // Runtime code as embedded in the creation input, with zeroed immutables
+	RuntimeOffset        int          `json:"runtime_offset"`        // Offset of the runtime code within the creation input
+	Data                 []byte       `json:"data"`                  // Code copied by the init code after the runtime code, such as the creation code of contracts deployed by the constructor
+	ArgumentsOffset      int          `json:"arguments_offset"`      // Offset of the constructor arguments within the creation input
+	ConstructorArguments []byte       `json:"constructor_arguments"` // ABI encoded constructor arguments appended to the creation code
+	Metadata             *Metadata    `json:"metadata"`              // CBOR metadata of the runtime code, nil if not present
+	Immutables           []*Immutable `json:"immutables"`            // Immutable values written into the runtime code on deployment
+}
+
+// Immutable represents an immutable variable placeholder in the runtime code. Solc reserves 32 zero bytes,
+// pushed by PUSH32, for every reference to an immutable variable and the constructor fills them in before
+// the runtime code is returned.
+type Immutable struct {
+	Offset int         `json:"offset"` // Offset of the placeholder within the runtime code
+	Value  common.Hash `json:"value"`  // Value written into the placeholder on deployment
+}
+
+// GetOffset returns the offset of the placeholder within the runtime code.
+func (i *Immutable) GetOffset() int {
+	return i.Offset
+}
+
+// GetValue returns the value written into the placeholder on deployment.
+func (i *Immutable) GetValue() common.Hash {
+	return i.Value
+}
+
+// GetInput returns the raw contract creation input.
+func (c *Creation) GetInput() []byte {
+	return c.input
+}
+
+// GetInitCode returns the code executed on deployment.
+func (c *Creation) GetInitCode() []byte {
+	return c.InitCode
+}
+
+// GetRuntimeCode returns the runtime code as embedded in the creation input.
+func (c *Creation) GetRuntimeCode() []byte {
+	return c.RuntimeCode
+}
+
+// GetRuntimeOffset returns the offset of the runtime code within the creation input.
+func (c *Creation) GetRuntimeOffset() int {
+	return c.RuntimeOffset
+}
+
+// GetData returns the code copied by the init code after the runtime code.
+func (c *Creation) GetData() []byte {
+	return c.Data
+}
+
+// GetArgumentsOffset returns the offset of the constructor arguments within the creation input.
+func (c *Creation) GetArgumentsOffset() int {
+	return c.ArgumentsOffset
+}
+
+// GetConstructorArguments returns the ABI encoded constructor arguments.
+func (c *Creation) GetConstructorArguments() []byte {
+	return c.ConstructorArguments
+}
+
+// HasConstructorArguments returns whether constructor arguments are appended to the creation code.
+func (c *Creation) HasConstructorArguments() bool {
+	return len(c.ConstructorArguments) > 0
+}
+
+// GetMetadata returns the CBOR metadata of the runtime code, or nil if it is not present.
+func (c *Creation) GetMetadata() *Metadata {
+	return c.Metadata
+}
+
+// GetImmutables returns the immutable values resolved by ResolveImmutables.
+func (c *Creation) GetImmutables() []*Immutable {
+	return c.Immutables
+}
+
+// SetArgumentsOffset moves the start of the constructor arguments to the given offset. It is used once the
+// exact length of the creation code is known, for example from the compiled creation bytecode.
+func (c *Creation) SetArgumentsOffset(offset int) error {
+	runtimeEnd := c.RuntimeOffset + len(c.RuntimeCode)
+	if offset < runtimeEnd || offset > len(c.input) {
+		return fmt.Errorf("arguments offset %d out of range [%d, %d]", offset, runtimeEnd, len(c.input))
+	}
+
+	c.ArgumentsOffset = offset
+	c.Data = c.input[runtimeEnd:offset]
+	c.ConstructorArguments = c.input[offset:]
+	return nil
+}
+
+// DecodeConstructor decodes the constructor arguments using the provided constructor ABI.
+func (c *Creation) DecodeConstructor(constructorAbi string) (*Constructor, error) {
+	return DecodeConstructorFromAbi(c.ConstructorArguments, constructorAbi)
+}
+
+// ResolveImmutables resolves the immutable values by diffing the deployed runtime code against the runtime
+// code embedded in the creation input, which still contains the zeroed placeholders.
+func (c *Creation) ResolveImmutables(deployed []byte) error {
+	immutables, err := DiffImmutables(deployed, c.RuntimeCode)
+	if err != nil {
+		return err
+	}
+
+	c.Immutables = immutables
+	return nil
+}
+
+// creationValue is a value on the stack tracked while scanning the init code.
+type creationValue struct {
+	value    *big.Int // Value of a constant, nil if not known.
+	codeSize bool     // Whether the value is derived from CODESIZE.
+}
+
+// codeCopy is a CODECOPY of the init code with a constant offset.
+type codeCopy struct {
+	instruction int      // Offset of the CODECOPY instruction.
+	offset      int      // Offset of the copied code.
+	size        *big.Int // Size of the copied code, nil if not constant.
+	codeSize    bool     // Whether the size is derived from CODESIZE, which copies the constructor arguments.
+	returned    bool     // Whether the copied code is returned within the same basic block.
+}
+
+// SplitCreationBytecode splits the contract creation input into the init code, the runtime code, the data
+// following the runtime code and the trailing constructor arguments, and decodes the CBOR metadata of the
+// runtime code.
+//
+// The runtime code is the first constant CODECOPY of code following the copying instruction whose size is
+// returned by RETURN within the same basic block. The constructor arguments start where the init code copies
+// them from, based on CODESIZE, or otherwise after the last code copied by the init code. As unused trailing
+// code cannot be told apart from constructor arguments, SetArgumentsOffset can refine the boundary.
+func SplitCreationBytecode(input []byte) (*Creation, error) {
+	if len(input) == 0 {
+		return nil, errors.New("provided creation bytecode slice is empty")
+	}
+
+	decompiler, err := opcode.NewDecompiler(context.Background(), input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create decompiler: %w", err)
+	}
+
+	if decompiler.IsEOF() {
+		return nil, errors.New("splitting of EOF initcode containers is not supported")
+	}
+
+	if err := decompiler.Decompile(); err != nil {
+		return nil, fmt.Errorf("failed to decompile creation bytecode: %w", err)
+	}
+
+	copies := scanCodeCopies(decompiler.GetInstructions())
+
+	var runtime *codeCopy
+	for _, copied := range copies {
+		if copied.returned && copied.size.Sign() > 0 && copied.offset > copied.instruction && copied.offset+int(copied.size.Int64()) <= len(input) {
+			runtime = copied
+			break
+		}
+	}
+
+	if runtime == nil {
+		return nil, errors.New("failed to locate the CODECOPY and RETURN deploying the runtime code")
+	}
+
+	runtimeEnd := runtime.offset + int(runtime.size.Int64())
+	toReturn := &Creation{
+		input:         input,
+		InitCode:      input[:runtime.offset],
+		RuntimeCode:   input[runtime.offset:runtimeEnd],
+		RuntimeOffset: runtime.offset,
+	}
+
+	// Only copies made by the init code are taken into account, the runtime code may copy its own code too.
+	argumentsOffset, copiedEnd := -1, runtimeEnd
+	for _, copied := range copies {
+		if copied.instruction >= runtime.offset {
+			break
+		}
+
+		switch {
+		case copied.codeSize && copied.offset >= runtimeEnd && copied.offset <= len(input):
+			argumentsOffset = copied.offset
+		case copied.size != nil && copied.offset+int(copied.size.Int64()) <= len(input):
+			copiedEnd = max(copiedEnd, copied.offset+int(copied.size.Int64()))
+		}
+	}
+
+	if argumentsOffset < 0 {
+		argumentsOffset = copiedEnd
+	}
+
+	if err := toReturn.SetArgumentsOffset(argumentsOffset); err != nil {
+		return nil, err
+	}
+
+	if metadata, err := DecodeContractMetadata(toReturn.RuntimeCode); err == nil {
+		toReturn.Metadata = metadata
+	}
+
+	return toReturn, nil
+}
+
+// scanCodeCopies tracks constants on the stack within each basic block and returns the CODECOPY
+// instructions with a constant offset, in the order of their appearance.
+func scanCodeCopies(instructions []opcode.Instruction) []*codeCopy {
+	toReturn := make([]*codeCopy, 0)
+	stack := make([]creationValue, 0)
+
+	pop := func() creationValue {
+		if len(stack) == 0 {
+			return creationValue{}
+		}
+		value := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		return value
+	}
+
+	// Stack items below the start of the basic block are unknown, so the stack is padded as required.
+	reserve := func(depth int) {
+		if missing := depth - len(stack); missing > 0 {
+			stack = append(make([]creationValue, missing), stack...)
+		}
+	}
+
+	blockCopies := make([]*codeCopy, 0)
+	for _, instruction := range instructions {
+		op := instruction.OpCode
+
+		if op == opcode.JUMPDEST {
+			stack, blockCopies = stack[:0], blockCopies[:0]
+		}
+
+		switch {
+		case op == opcode.PUSH0:
+			stack = append(stack, creationValue{value: new(big.Int)})
+		case op.IsPush():
+			stack = append(stack, creationValue{value: new(big.Int).SetBytes(instruction.Args)})
+		case op.IsDup():
+			depth := int(op-opcode.DUP1) + 1
+			reserve(depth)
+			stack = append(stack, stack[len(stack)-depth])
+		case op.IsSwap():
+			depth := int(op-opcode.SWAP1) + 2
+			reserve(depth)
+			stack[len(stack)-1], stack[len(stack)-depth] = stack[len(stack)-depth], stack[len(stack)-1]
+		case op == opcode.CODESIZE:
+			stack = append(stack, creationValue{codeSize: true})
+		case op == opcode.ADD || op == opcode.SUB:
+			a, b := pop(), pop()
+			result := creationValue{codeSize: a.codeSize || b.codeSize}
+			if a.value != nil && b.value != nil {
+				if op == opcode.ADD {
+					result.value = new(big.Int).Add(a.value, b.value)
+				} else {
+					result.value = new(big.Int).Sub(a.value, b.value)
+				}
+				if result.value.Sign() < 0 || result.value.BitLen() > 256 {
+					result.value = nil
+				}
+			}
+			stack = append(stack, result)
+		case op == opcode.CODECOPY:
+			_, offset, size := pop(), pop(), pop()
+			if offset.value == nil || !offset.value.IsInt64() || (size.value == nil && !size.codeSize) {
+				continue
+			}
+			if size.value != nil && !size.value.IsInt64() {
+				continue
+			}
+			copied := &codeCopy{
+				instruction: instruction.Offset,
+				offset:      int(offset.value.Int64()),
+				size:        size.value,
+				codeSize:    size.value == nil,
+			}
+			toReturn = append(toReturn, copied)
+			blockCopies = append(blockCopies, copied)
+		case op == opcode.RETURN:
+			_, size := pop(), pop()
+			if size.value != nil {
+				for _, copied := range blockCopies {
+					if copied.size != nil && copied.size.Cmp(size.value) == 0 {
+						copied.returned = true
+					}
+				}
+			}
+		default:
+			for i := 0; i < op.StackInputs(); i++ {
+				pop()
+			}
+			for i := 0; i < op.StackOutputs(); i++ {
+				stack = append(stack, creationValue{})
+			}
+		}
+
+		if op.IsTerminator() {
+			stack, blockCopies = stack[:0], blockCopies[:0]
+		}
+	}
+
+	return toReturn
+}
+
+// DiffImmutables resolves the immutable values of the deployed runtime code by diffing it against the
+// compiled runtime code, in which immutables are zeroed PUSH32 placeholders. Trailing CBOR metadata is
+// ignored, as its hash changes with the compilation settings. An error is returned if the code differs
+// anywhere other than at the placeholders.
+func DiffImmutables(deployed []byte, compiled []byte) ([]*Immutable, error) {
+	deployed, compiled = stripMetadata(deployed), stripMetadata(compiled)
+	if len(deployed) != len(compiled) {
+		return nil, fmt.Errorf("deployed runtime code length %d does not match compiled runtime code length %d", len(deployed), len(compiled))
+	}
+
+	decompiler, err := opcode.NewDecompiler(context.Background(), compiled)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create decompiler: %w", err)
+	}
+
+	if err := decompiler.Decompile(); err != nil {
+		return nil, fmt.Errorf("failed to decompile compiled runtime code: %w", err)
+	}
+
+	toReturn := make([]*Immutable, 0)
+	placeholder := make([]byte, common.HashLength)
+	filled := bytes.Clone(deployed)
+	for _, instruction := range decompiler.GetInstructionsByOpCode(opcode.PUSH32) {
+		start := instruction.Offset + 1
+		if !bytes.Equal(instruction.Args, placeholder) || bytes.Equal(deployed[start:start+common.HashLength], placeholder) {
+			continue
+		}
+
+		toReturn = append(toReturn, &Immutable{
+			Offset: start,
+			Value:  common.BytesToHash(deployed[start : start+common.HashLength]),
+		})
+		copy(filled[start:start+common.HashLength], placeholder)
+	}
+
+	for offset := range filled {
+		if filled[offset] != compiled[offset] {
+			return nil, fmt.Errorf("deployed runtime code differs from compiled runtime code at offset %d outside of immutable placeholders", offset)
+		}
+	}
+
+	return toReturn, nil
+}
+
+// stripMetadata returns the code preceding the CBOR metadata, or the code itself if it carries no metadata.
+func stripMetadata(code []byte) []byte {
+	if metadata, err := DecodeContractMetadata(code); err == nil {
+		return metadata.GetExecutionBytecode()
+	}
+	return code
+}
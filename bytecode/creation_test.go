@@ -0,0 +1,157 @@
+package bytecode
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// creationRuntime is runtime code with a single immutable placeholder:
+// PUSH32 0x00..00, PUSH1 0x00, MSTORE, PUSH1 0x20, PUSH1 0x00, RETURN
+var creationRuntime = "7f" + strings.Repeat("00", 32) + "60005260206000f3"
+
+func TestSplitCreationBytecode(t *testing.T) {
+	arguments := strings.Repeat("00", 31) + "2a" + strings.Repeat("00", 31) + "01"
+
+	tests := []struct {
+		name            string
+		input           string
+		initCode        string
+		runtimeOffset   int
+		data            string
+		argumentsOffset int
+		arguments       string
+		wantErr         bool
+	}{
+		{
+			// JUMPDEST, PUSH1 0x29, DUP1, PUSH2 0x000c, PUSH0, CODECOPY, PUSH0, RETURN, INVALID
+			name:            "Without Constructor Arguments",
+			input:           "5b60298061000c5f395ff3fe" + creationRuntime,
+			initCode:        "5b60298061000c5f395ff3fe",
+			runtimeOffset:   12,
+			argumentsOffset: 53,
+		},
+		{
+			// PUSH1 0x40, MLOAD, PUSH2 0x0045, CODESIZE, SUB, DUP1, PUSH2 0x0045, DUP4, CODECOPY, POP, POP,
+			// followed by the deployment of the runtime code at offset 0x1c.
+			name:            "Legacy Constructor Arguments",
+			input:           "604051610045380380610045833950505b60298061001c5f395ff3fe" + creationRuntime + arguments,
+			initCode:        "604051610045380380610045833950505b60298061001c5f395ff3fe",
+			runtimeOffset:   28,
+			argumentsOffset: 69,
+			arguments:       arguments,
+		},
+		{
+			// PUSH1 0x02, PUSH2 0x0041, PUSH0, CODECOPY copies two bytes of data following the runtime code,
+			// SWAP1 twice shuffles the constants of the deployment across the stack.
+			name:            "Copied Data",
+			input:           "60026100415f395b60296100185f9090395f602990f3" + "fefe" + creationRuntime + "6001" + arguments,
+			initCode:        "60026100415f395b60296100185f9090395f602990f3fefe",
+			runtimeOffset:   24,
+			data:            "6001",
+			argumentsOffset: 67,
+			arguments:       arguments,
+		},
+		{
+			// PUSH1 0x29, PUSH1 0x0c, PUSH0, CODECOPY, STOP
+			name:    "Runtime Not Returned",
+			input:   "6029600c5f3900" + creationRuntime,
+			wantErr: true,
+		},
+		{
+			name:    "Empty Input",
+			input:   "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creation, err := SplitCreationBytecode(common.FromHex(tt.input))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, common.FromHex(tt.input), creation.GetInput())
+			assert.Equal(t, tt.initCode, common.Bytes2Hex(creation.GetInitCode()))
+			assert.Equal(t, creationRuntime, common.Bytes2Hex(creation.GetRuntimeCode()))
+			assert.Equal(t, tt.runtimeOffset, creation.GetRuntimeOffset())
+			assert.Equal(t, tt.data, common.Bytes2Hex(creation.GetData()))
+			assert.Equal(t, tt.argumentsOffset, creation.GetArgumentsOffset())
+			assert.Equal(t, tt.arguments, common.Bytes2Hex(creation.GetConstructorArguments()))
+			assert.Equal(t, tt.arguments != "", creation.HasConstructorArguments())
+			assert.Nil(t, creation.GetMetadata())
+		})
+	}
+}
+
+func TestCreationConstructor(t *testing.T) {
+	arguments := strings.Repeat("00", 31) + "2a" + strings.Repeat("00", 31) + "01"
+	creation, err := SplitCreationBytecode(common.FromHex("5b60298061000c5f395ff3fe" + creationRuntime + arguments))
+	require.NoError(t, err)
+	assert.Equal(t, arguments, common.Bytes2Hex(creation.GetConstructorArguments()))
+
+	require.Error(t, creation.SetArgumentsOffset(20))
+	require.NoError(t, creation.SetArgumentsOffset(117))
+	assert.False(t, creation.HasConstructorArguments())
+	assert.Len(t, creation.GetData(), 64)
+	require.NoError(t, creation.SetArgumentsOffset(53))
+
+	constructor, err := creation.DecodeConstructor(`[{"inputs":[{"name":"answer","type":"uint256"},{"name":"enabled","type":"bool"}],"stateMutability":"nonpayable","type":"constructor"}]`)
+	require.NoError(t, err)
+	require.Len(t, constructor.Arguments, 2)
+	assert.Equal(t, "42", constructor.Arguments[0].Value)
+	assert.Equal(t, "true", constructor.Arguments[1].Value)
+}
+
+func TestDiffImmutables(t *testing.T) {
+	value := strings.Repeat("11", 32)
+
+	tests := []struct {
+		name       string
+		deployed   string
+		immutables []*Immutable
+		wantErr    bool
+	}{
+		{
+			name:       "Resolved Immutable",
+			deployed:   "7f" + value + "60005260206000f3",
+			immutables: []*Immutable{{Offset: 1, Value: common.HexToHash(value)}},
+		},
+		{
+			name:       "Zero Immutable",
+			deployed:   creationRuntime,
+			immutables: []*Immutable{},
+		},
+		{
+			name:     "Code Mismatch",
+			deployed: "7f" + value + "60015260206000f3",
+			wantErr:  true,
+		},
+		{
+			name:     "Length Mismatch",
+			deployed: "7f" + value + "6000f3",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creation, err := SplitCreationBytecode(common.FromHex("5b60298061000c5f395ff3fe" + creationRuntime))
+			require.NoError(t, err)
+
+			err = creation.ResolveImmutables(common.FromHex(tt.deployed))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.immutables, creation.GetImmutables())
+		})
+	}
+}
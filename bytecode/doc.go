@@ -1,5 +1,6 @@
 /*
 Package bytecode provides tools for decoding and analyzing Ethereum contract, transaction, events, and log bytecode.
 The package is designed to extract and interpret metadata from Ethereum contract creation bytecode. It provides a Metadata struct that represents the metadata contained in the bytecode, as defined by the Solidity compiler. This includes information such as the IPFS hash of the metadata, the Swarm hash of the metadata, experimental metadata, and the version of the Solidity compiler used.
+Creation bytecode can be split into init code, runtime code, CBOR metadata and trailing constructor arguments without an ABI, and immutable values can be resolved by diffing deployed runtime code against its compiled counterpart.
 */
 package bytecode
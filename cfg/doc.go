@@ -1,2 +1,5 @@
 // Package cfg provides structures and functions for building and manipulating control flow graphs (CFGs) of Solidity contracts.
+//
+// Builder constructs the contract-level graph of imports and inheritance, while BuildFunctionGraph and
+// BuildFunctionGraphs construct intra-function graphs of basic blocks with modifiers and inline assembly expanded.
 package cfg
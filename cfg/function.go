@@ -0,0 +1,258 @@
+package cfg
+
+import (
+	ast_pb "github.com/unpackdev/protos/dist/go/ast"
+	"github.com/unpackdev/solgo/ast"
+)
+
+// BlockKind describes the role of a basic block within a function control flow graph.
+type BlockKind string
+
+const (
+	BlockEntry     BlockKind = "entry"     // Synthetic block every function graph starts from.
+	BlockExit      BlockKind = "exit"      // Synthetic block reached on normal termination.
+	BlockRevert    BlockKind = "revert"    // Synthetic block reached when execution reverts.
+	BlockBasic     BlockKind = "basic"     // Straight-line sequence of statements.
+	BlockCondition BlockKind = "condition" // Block that ends with a branching condition.
+	BlockLoop      BlockKind = "loop"      // Loop header holding the loop condition.
+	BlockTry       BlockKind = "try"       // Block holding the external call of a try statement.
+	BlockCatch     BlockKind = "catch"     // Entry block of a catch clause.
+	BlockModifier  BlockKind = "modifier"  // Entry block of an expanded modifier body.
+	BlockAssembly  BlockKind = "assembly"  // Entry block of an inline assembly (Yul) section.
+)
+
+// EdgeKind describes why control may flow from one basic block to another.
+type EdgeKind string
+
+const (
+	EdgeFallthrough EdgeKind = "fallthrough"
+	EdgeTrue        EdgeKind = "true"
+	EdgeFalse       EdgeKind = "false"
+	EdgeLoopBack    EdgeKind = "loop_back"
+	EdgeBreak       EdgeKind = "break"
+	EdgeContinue    EdgeKind = "continue"
+	EdgeReturn      EdgeKind = "return"
+	EdgeRevert      EdgeKind = "revert"
+	EdgeTrySuccess  EdgeKind = "try_success"
+	EdgeCatch       EdgeKind = "catch"
+	EdgeSwitchCase  EdgeKind = "switch_case"
+	EdgePlaceholder EdgeKind = "placeholder"
+	EdgeYulFunction EdgeKind = "yul_function"
+)
+
+// Statement is a lightweight reference to an AST statement placed into a basic block.
+type Statement struct {
+	Id       int64                  `json:"id"`
+	NodeType ast_pb.NodeType        `json:"node_type"`
+	Src      ast.SrcNode            `json:"src"`
+	Node     ast.Node[ast.NodeType] `json:"-"`
+}
+
+// GetNode returns the AST node the statement refers to.
+func (s *Statement) GetNode() ast.Node[ast.NodeType] {
+	return s.Node
+}
+
+// BasicBlock is a maximal sequence of statements with a single entry and a single exit point.
+type BasicBlock struct {
+	Id         int          `json:"id"`
+	Kind       BlockKind    `json:"kind"`
+	Label      string       `json:"label,omitempty"`
+	Statements []*Statement `json:"statements"`
+	Reachable  bool         `json:"reachable"`
+}
+
+// GetId returns the identifier of the block, unique within its function graph.
+func (bb *BasicBlock) GetId() int {
+	return bb.Id
+}
+
+// GetKind returns the kind of the block.
+func (bb *BasicBlock) GetKind() BlockKind {
+	return bb.Kind
+}
+
+// GetStatements returns the statements contained in the block in execution order.
+func (bb *BasicBlock) GetStatements() []*Statement {
+	return bb.Statements
+}
+
+// IsReachable returns true if the block can be reached from the function entry block.
+func (bb *BasicBlock) IsReachable() bool {
+	return bb.Reachable
+}
+
+// IsEmpty returns true if the block holds no statements.
+func (bb *BasicBlock) IsEmpty() bool {
+	return len(bb.Statements) == 0
+}
+
+// appendStatement adds the AST node to the end of the block.
+func (bb *BasicBlock) appendStatement(node ast.Node[ast.NodeType]) {
+	if node == nil {
+		return
+	}
+
+	bb.Statements = append(bb.Statements, &Statement{
+		Id:       node.GetId(),
+		NodeType: node.GetType(),
+		Src:      node.GetSrc(),
+		Node:     node,
+	})
+}
+
+// Edge is a directed edge between two basic blocks of a function graph.
+type Edge struct {
+	From int      `json:"from"`
+	To   int      `json:"to"`
+	Kind EdgeKind `json:"kind"`
+}
+
+// FunctionGraph is the intra-function control flow graph of a single function, constructor,
+// fallback or receive body, with any applied modifiers expanded in place of their placeholders.
+type FunctionGraph struct {
+	Name     string          `json:"name"`
+	Contract string          `json:"contract"`
+	Kind     ast_pb.NodeType `json:"kind"`
+	EntryId  int             `json:"entry"`
+	ExitId   int             `json:"exit"`
+	RevertId int             `json:"revert"`
+	Blocks   []*BasicBlock   `json:"blocks"`
+	Edges    []*Edge         `json:"edges"`
+}
+
+// NewFunctionGraph creates an empty function graph with its synthetic entry, exit and revert blocks.
+func NewFunctionGraph(contract string, name string, kind ast_pb.NodeType) *FunctionGraph {
+	g := &FunctionGraph{
+		Name:     name,
+		Contract: contract,
+		Kind:     kind,
+		Blocks:   make([]*BasicBlock, 0),
+		Edges:    make([]*Edge, 0),
+	}
+
+	g.EntryId = g.NewBlock(BlockEntry, "entry").Id
+	g.ExitId = g.NewBlock(BlockExit, "exit").Id
+	g.RevertId = g.NewBlock(BlockRevert, "revert").Id
+	return g
+}
+
+// GetName returns the name of the function the graph was built for.
+func (g *FunctionGraph) GetName() string {
+	return g.Name
+}
+
+// GetContract returns the name of the contract the function belongs to.
+func (g *FunctionGraph) GetContract() string {
+	return g.Contract
+}
+
+// GetBlocks returns all basic blocks of the graph ordered by their identifiers.
+func (g *FunctionGraph) GetBlocks() []*BasicBlock {
+	return g.Blocks
+}
+
+// GetEdges returns all edges of the graph in the order they were added.
+func (g *FunctionGraph) GetEdges() []*Edge {
+	return g.Edges
+}
+
+// GetBlock returns the block with the given identifier or nil if it does not exist.
+func (g *FunctionGraph) GetBlock(id int) *BasicBlock {
+	if id < 0 || id >= len(g.Blocks) {
+		return nil
+	}
+	return g.Blocks[id]
+}
+
+// GetEntry returns the synthetic entry block.
+func (g *FunctionGraph) GetEntry() *BasicBlock {
+	return g.GetBlock(g.EntryId)
+}
+
+// GetExit returns the synthetic exit block.
+func (g *FunctionGraph) GetExit() *BasicBlock {
+	return g.GetBlock(g.ExitId)
+}
+
+// GetRevert returns the synthetic revert block.
+func (g *FunctionGraph) GetRevert() *BasicBlock {
+	return g.GetBlock(g.RevertId)
+}
+
+// NewBlock appends a new empty block of the given kind to the graph and returns it.
+func (g *FunctionGraph) NewBlock(kind BlockKind, label string) *BasicBlock {
+	block := &BasicBlock{
+		Id:         len(g.Blocks),
+		Kind:       kind,
+		Label:      label,
+		Statements: make([]*Statement, 0),
+	}
+	g.Blocks = append(g.Blocks, block)
+	return block
+}
+
+// AddEdge connects two blocks with an edge of the given kind. Nil blocks are ignored.
+func (g *FunctionGraph) AddEdge(from *BasicBlock, to *BasicBlock, kind EdgeKind) {
+	if from == nil || to == nil {
+		return
+	}
+	g.Edges = append(g.Edges, &Edge{From: from.Id, To: to.Id, Kind: kind})
+}
+
+// GetSuccessors returns the outgoing edges of the block with the given identifier.
+func (g *FunctionGraph) GetSuccessors(id int) []*Edge {
+	edges := make([]*Edge, 0)
+	for _, edge := range g.Edges {
+		if edge.From == id {
+			edges = append(edges, edge)
+		}
+	}
+	return edges
+}
+
+// GetPredecessors returns the incoming edges of the block with the given identifier.
+func (g *FunctionGraph) GetPredecessors(id int) []*Edge {
+	edges := make([]*Edge, 0)
+	for _, edge := range g.Edges {
+		if edge.To == id {
+			edges = append(edges, edge)
+		}
+	}
+	return edges
+}
+
+// GetUnreachableBlocks returns blocks that cannot be reached from the entry block,
+// which usually indicates dead code following a return, revert, break or continue.
+// Synthetic exit and revert blocks are never reported.
+func (g *FunctionGraph) GetUnreachableBlocks() []*BasicBlock {
+	blocks := make([]*BasicBlock, 0)
+	for _, block := range g.Blocks {
+		if !block.Reachable && block.Kind != BlockExit && block.Kind != BlockRevert {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
+
+// markReachable walks the graph from the entry block and flags every visited block as reachable.
+func (g *FunctionGraph) markReachable() {
+	successors := make(map[int][]int, len(g.Blocks))
+	for _, edge := range g.Edges {
+		successors[edge.From] = append(successors[edge.From], edge.To)
+	}
+
+	queue := []int{g.EntryId}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		block := g.GetBlock(id)
+		if block == nil || block.Reachable {
+			continue
+		}
+
+		block.Reachable = true
+		queue = append(queue, successors[id]...)
+	}
+}
@@ -0,0 +1,673 @@
+package cfg
+
+import (
+	"errors"
+	"fmt"
+
+	ast_pb "github.com/unpackdev/protos/dist/go/ast"
+	"github.com/unpackdev/solgo/ast"
+	"github.com/unpackdev/solgo/ir"
+)
+
+// loopTarget holds the blocks that break and continue statements jump to within a loop.
+type loopTarget struct {
+	breakTo    *BasicBlock
+	continueTo *BasicBlock
+}
+
+// functionWalker walks a function body (and the bodies of its modifiers) and populates a FunctionGraph.
+type functionWalker struct {
+	graph     *FunctionGraph
+	body      *ast.BodyNode
+	modifiers []*ast.ModifierDefinition
+	loops     []loopTarget
+	// expansion is the continuation of the modifier currently being walked. Placeholder
+	// statements expand the next modifier (or the function body) and return statements
+	// jump to the block following the placeholder of the enclosing modifier.
+	expansion []*BasicBlock
+	depth     int
+	// yulSeen tracks Yul statement containers walked within the current assembly block,
+	// as the AST may list the same container more than once within an assembly body.
+	yulSeen map[int64]bool
+	// yulFunctions holds the blocks that leave statements jump to, one for each Yul function
+	// definition being walked.
+	yulFunctions []*BasicBlock
+}
+
+// BuildFunctionGraph constructs the intra-function control flow graph of the given function.
+// Modifiers applied to the function are expanded in place of their placeholder statements,
+// so the resulting graph reflects the code that actually executes on a call.
+func (b *Builder) BuildFunctionGraph(contract *ir.Contract, fn *ir.Function) (*FunctionGraph, error) {
+	if contract == nil || fn == nil || fn.GetAST() == nil {
+		return nil, errors.New("function is not set")
+	}
+
+	return b.buildFunctionGraph(
+		contract.GetName(), fn.GetName(), fn.GetKind(), fn.GetAST().GetBody(), fn.GetAST().GetModifiers(),
+	), nil
+}
+
+// BuildFunctionGraphs constructs control flow graphs for every implemented function, constructor,
+// fallback and receive function of the given contract. If the contract name is empty, the entry
+// contract is used. Returns an error if the contract cannot be found in the IR.
+func (b *Builder) BuildFunctionGraphs(contractName string) ([]*FunctionGraph, error) {
+	root := b.builder.GetRoot()
+	if root == nil {
+		return nil, errors.New("root node is not set in IR builder")
+	}
+
+	var contract *ir.Contract
+	if contractName == "" {
+		contract = root.GetEntryContract()
+	} else {
+		for _, c := range root.GetContracts() {
+			if c.GetName() == contractName {
+				contract = c
+				break
+			}
+		}
+	}
+
+	if contract == nil {
+		return nil, fmt.Errorf("contract %s not found", contractName)
+	}
+
+	graphs := make([]*FunctionGraph, 0)
+
+	if constructor := contract.GetConstructor(); constructor != nil && constructor.GetAST() != nil {
+		graphs = append(graphs, b.buildFunctionGraph(
+			contract.GetName(), "constructor", constructor.GetAST().GetType(),
+			constructor.GetAST().GetBody(), constructor.GetAST().GetModifiers(),
+		))
+	}
+
+	for _, fn := range contract.GetFunctions() {
+		if !fn.IsImplemented() || fn.GetAST() == nil {
+			continue
+		}
+
+		graph, err := b.BuildFunctionGraph(contract, fn)
+		if err != nil {
+			return nil, err
+		}
+		graphs = append(graphs, graph)
+	}
+
+	if fallback := contract.GetFallback(); fallback != nil && fallback.GetAST() != nil {
+		graphs = append(graphs, b.buildFunctionGraph(
+			contract.GetName(), "fallback", fallback.GetAST().GetType(),
+			fallback.GetAST().GetBody(), fallback.GetAST().GetModifiers(),
+		))
+	}
+
+	if receive := contract.GetReceive(); receive != nil && receive.GetAST() != nil {
+		graphs = append(graphs, b.buildFunctionGraph(
+			contract.GetName(), "receive", receive.GetAST().GetType(),
+			receive.GetAST().GetBody(), receive.GetAST().GetModifiers(),
+		))
+	}
+
+	return graphs, nil
+}
+
+// buildFunctionGraph resolves modifier definitions and walks the body into a new FunctionGraph.
+func (b *Builder) buildFunctionGraph(contract string, name string, kind ast_pb.NodeType, body *ast.BodyNode, invocations []*ast.ModifierInvocation) *FunctionGraph {
+	walker := &functionWalker{
+		graph:        NewFunctionGraph(contract, name, kind),
+		body:         body,
+		modifiers:    make([]*ast.ModifierDefinition, 0),
+		loops:        make([]loopTarget, 0),
+		expansion:    make([]*BasicBlock, 0),
+		yulSeen:      make(map[int64]bool),
+		yulFunctions: make([]*BasicBlock, 0),
+	}
+
+	for _, invocation := range invocations {
+		// Invocations that do not resolve to a modifier are base constructor calls.
+		if modifier := b.lookupModifier(contract, invocation.GetName()); modifier != nil {
+			walker.modifiers = append(walker.modifiers, modifier)
+		}
+	}
+
+	entry := walker.graph.GetEntry()
+	last := walker.expand(entry, walker.graph.GetExit())
+	walker.graph.AddEdge(last, walker.graph.GetExit(), EdgeFallthrough)
+	walker.graph.markReachable()
+
+	return walker.graph
+}
+
+// lookupModifier finds the modifier definition with the given name visible from the contract,
+// searching the contract and its base contracts in the order of their C3 linearisation, the same
+// order solc resolves the virtual modifiers in.
+func (b *Builder) lookupModifier(contractName string, modifierName string) *ast.ModifierDefinition {
+	for _, name := range b.linearize(contractName, make(map[string]bool)) {
+		if modifier := b.findModifierDefinition(name, modifierName); modifier != nil {
+			return modifier
+		}
+	}
+
+	return nil
+}
+
+// linearize returns the C3 linearisation of the contract, starting with the contract itself and
+// followed by its base contracts from the most derived one. Bases listed later in the inheritance
+// specifier are the more derived ones. Inheritance graphs that cannot be linearised, which solc
+// rejects, fall back to the remaining bases in the order they were listed.
+func (b *Builder) linearize(contractName string, visiting map[string]bool) []string {
+	toReturn := []string{contractName}
+
+	node := b.graph.GetNode(contractName)
+	if node == nil || visiting[contractName] {
+		return toReturn
+	}
+	visiting[contractName] = true
+	defer delete(visiting, contractName)
+
+	bases := make([]string, 0, len(node.Inherits))
+	for i := len(node.Inherits) - 1; i >= 0; i-- {
+		bases = append(bases, node.Inherits[i].BaseName.GetName())
+	}
+
+	sequences := make([][]string, 0, len(bases)+1)
+	for _, base := range bases {
+		sequences = append(sequences, b.linearize(base, visiting))
+	}
+	sequences = append(sequences, bases)
+
+	for {
+		candidate := ""
+		for _, sequence := range sequences {
+			if len(sequence) > 0 && !inTail(sequences, sequence[0]) {
+				candidate = sequence[0]
+				break
+			}
+		}
+
+		if candidate == "" {
+			for _, sequence := range sequences {
+				if len(sequence) > 0 {
+					candidate = sequence[0]
+					break
+				}
+			}
+		}
+
+		if candidate == "" {
+			return toReturn
+		}
+
+		toReturn = append(toReturn, candidate)
+		for i, sequence := range sequences {
+			remaining := make([]string, 0, len(sequence))
+			for _, name := range sequence {
+				if name != candidate {
+					remaining = append(remaining, name)
+				}
+			}
+			sequences[i] = remaining
+		}
+	}
+}
+
+// inTail reports whether the contract appears in any of the sequences other than at their head.
+func inTail(sequences [][]string, name string) bool {
+	for _, sequence := range sequences {
+		for i := 1; i < len(sequence); i++ {
+			if sequence[i] == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// findModifierDefinition looks up a modifier definition declared directly within the named contract.
+func (b *Builder) findModifierDefinition(contractName string, modifierName string) *ast.ModifierDefinition {
+	astBuilder := b.builder.GetAstBuilder()
+	if astBuilder == nil || astBuilder.GetRoot() == nil {
+		return nil
+	}
+
+	for _, unit := range astBuilder.GetRoot().GetSourceUnits() {
+		for _, node := range unit.GetNodes() {
+			named, ok := node.(interface{ GetName() string })
+			if !ok || named.GetName() != contractName {
+				continue
+			}
+
+			for _, child := range node.GetNodes() {
+				if modifier, ok := child.(*ast.ModifierDefinition); ok && modifier.GetName() == modifierName {
+					return modifier
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// expand walks the modifier at the current depth or, once all modifiers are consumed, the function body.
+// The returnTo block is where return statements of the walked body transfer control to.
+// It returns the block that is open at the end of the walked body or nil if it never falls through.
+func (w *functionWalker) expand(current *BasicBlock, returnTo *BasicBlock) *BasicBlock {
+	w.expansion = append(w.expansion, returnTo)
+	defer func() { w.expansion = w.expansion[:len(w.expansion)-1] }()
+
+	// Loops of the enclosing modifier are not visible to the expanded body.
+	loops := w.loops
+	w.loops = make([]loopTarget, 0)
+	defer func() { w.loops = loops }()
+
+	if w.depth < len(w.modifiers) {
+		modifier := w.modifiers[w.depth]
+		block := w.graph.NewBlock(BlockModifier, modifier.GetName())
+		w.graph.AddEdge(current, block, EdgeFallthrough)
+
+		w.depth++
+		defer func() { w.depth-- }()
+
+		if modifier.Body == nil {
+			return block
+		}
+		return w.walkStatements(modifier.Body.GetStatements(), block)
+	}
+
+	if w.body == nil {
+		return current
+	}
+
+	block := w.graph.NewBlock(BlockBasic, "body")
+	w.graph.AddEdge(current, block, EdgeFallthrough)
+	return w.walkStatements(w.body.GetStatements(), block)
+}
+
+// returnTarget returns the block that return statements in the currently walked body jump to.
+func (w *functionWalker) returnTarget() *BasicBlock {
+	if len(w.expansion) == 0 {
+		return w.graph.GetExit()
+	}
+	return w.expansion[len(w.expansion)-1]
+}
+
+// open returns the current block or, when the previous statement terminated control flow,
+// a fresh block that will have no predecessors and therefore be marked as unreachable.
+func (w *functionWalker) open(current *BasicBlock) *BasicBlock {
+	if current != nil {
+		return current
+	}
+	return w.graph.NewBlock(BlockBasic, "unreachable")
+}
+
+// walkStatements walks a list of statements starting in the current block.
+func (w *functionWalker) walkStatements(statements []ast.Node[ast.NodeType], current *BasicBlock) *BasicBlock {
+	for _, statement := range statements {
+		if statement == nil {
+			continue
+		}
+		current = w.walkStatement(statement, w.open(current))
+	}
+	return current
+}
+
+// walkStatement appends a single Solidity statement to the graph and returns the block that
+// control flows into afterwards, or nil if the statement never falls through.
+func (w *functionWalker) walkStatement(statement ast.Node[ast.NodeType], current *BasicBlock) *BasicBlock {
+	switch node := statement.(type) {
+	case *ast.BodyNode:
+		return w.walkStatements(node.GetStatements(), current)
+
+	case *ast.IfStatement:
+		condition := w.graph.NewBlock(BlockCondition, "if")
+		condition.appendStatement(node.GetCondition())
+		w.graph.AddEdge(current, condition, EdgeFallthrough)
+
+		then := w.graph.NewBlock(BlockBasic, "then")
+		w.graph.AddEdge(condition, then, EdgeTrue)
+		thenEnd := w.walkOptional(node.GetBody(), then)
+
+		join := w.graph.NewBlock(BlockBasic, "endif")
+		w.graph.AddEdge(thenEnd, join, EdgeFallthrough)
+
+		if node.GetElse() == nil {
+			w.graph.AddEdge(condition, join, EdgeFalse)
+			return join
+		}
+
+		otherwise := w.graph.NewBlock(BlockBasic, "else")
+		w.graph.AddEdge(condition, otherwise, EdgeFalse)
+		w.graph.AddEdge(w.walkOptional(node.GetElse(), otherwise), join, EdgeFallthrough)
+		return join
+
+	case *ast.ForStatement:
+		current.appendStatement(node.GetInitialiser())
+
+		head := w.graph.NewBlock(BlockLoop, "for")
+		head.appendStatement(node.GetCondition())
+		w.graph.AddEdge(current, head, EdgeFallthrough)
+
+		closure := w.graph.NewBlock(BlockBasic, "for_closure")
+		closure.appendStatement(node.GetClosure())
+		w.graph.AddEdge(closure, head, EdgeLoopBack)
+
+		exit := w.graph.NewBlock(BlockBasic, "endfor")
+		if node.GetCondition() != nil {
+			w.graph.AddEdge(head, exit, EdgeFalse)
+		}
+
+		body := w.graph.NewBlock(BlockBasic, "for_body")
+		w.graph.AddEdge(head, body, EdgeTrue)
+		bodyEnd := w.walkLoopBody(node.GetBody(), body, exit, closure)
+		w.graph.AddEdge(bodyEnd, closure, EdgeFallthrough)
+		return exit
+
+	case *ast.WhileStatement:
+		head := w.graph.NewBlock(BlockLoop, "while")
+		head.appendStatement(node.GetCondition())
+		w.graph.AddEdge(current, head, EdgeFallthrough)
+
+		exit := w.graph.NewBlock(BlockBasic, "endwhile")
+		w.graph.AddEdge(head, exit, EdgeFalse)
+
+		body := w.graph.NewBlock(BlockBasic, "while_body")
+		w.graph.AddEdge(head, body, EdgeTrue)
+		bodyEnd := w.walkLoopBody(node.GetBody(), body, exit, head)
+		w.graph.AddEdge(bodyEnd, head, EdgeLoopBack)
+		return exit
+
+	case *ast.DoWhileStatement:
+		body := w.graph.NewBlock(BlockBasic, "do_body")
+		w.graph.AddEdge(current, body, EdgeFallthrough)
+
+		condition := w.graph.NewBlock(BlockLoop, "do_while")
+		condition.appendStatement(node.GetCondition())
+
+		exit := w.graph.NewBlock(BlockBasic, "enddo")
+		bodyEnd := w.walkLoopBody(node.GetBody(), body, exit, condition)
+		w.graph.AddEdge(bodyEnd, condition, EdgeFallthrough)
+		w.graph.AddEdge(condition, body, EdgeLoopBack)
+		w.graph.AddEdge(condition, exit, EdgeFalse)
+		return exit
+
+	case *ast.TryStatement:
+		try := w.graph.NewBlock(BlockTry, "try")
+		try.appendStatement(node.GetExpression())
+		w.graph.AddEdge(current, try, EdgeFallthrough)
+
+		join := w.graph.NewBlock(BlockBasic, "endtry")
+
+		success := w.graph.NewBlock(BlockBasic, "try_body")
+		w.graph.AddEdge(try, success, EdgeTrySuccess)
+		w.graph.AddEdge(w.walkOptional(node.GetBody(), success), join, EdgeFallthrough)
+
+		for _, clause := range node.GetClauses() {
+			catch := w.graph.NewBlock(BlockCatch, "catch")
+			w.graph.AddEdge(try, catch, EdgeCatch)
+
+			if clause, ok := clause.(*ast.CatchStatement); ok {
+				if clause.GetName() != "" {
+					catch.Label = fmt.Sprintf("catch %s", clause.GetName())
+				}
+				w.graph.AddEdge(w.walkOptional(clause.GetBody(), catch), join, EdgeFallthrough)
+				continue
+			}
+			w.graph.AddEdge(catch, join, EdgeFallthrough)
+		}
+		return join
+
+	case *ast.ReturnStatement:
+		current.appendStatement(node)
+		w.graph.AddEdge(current, w.returnTarget(), EdgeReturn)
+		return nil
+
+	case *ast.RevertStatement:
+		current.appendStatement(node)
+		w.graph.AddEdge(current, w.graph.GetRevert(), EdgeRevert)
+		return nil
+
+	case *ast.BreakStatement:
+		current.appendStatement(node)
+		if len(w.loops) > 0 {
+			w.graph.AddEdge(current, w.loops[len(w.loops)-1].breakTo, EdgeBreak)
+		}
+		return nil
+
+	case *ast.ContinueStatement:
+		current.appendStatement(node)
+		if len(w.loops) > 0 {
+			w.graph.AddEdge(current, w.loops[len(w.loops)-1].continueTo, EdgeContinue)
+		}
+		return nil
+
+	case *ast.PrimaryExpression:
+		if node.GetType() == ast_pb.NodeType_PLACEHOLDER_STATEMENT && w.depth > 0 {
+			placeholder := w.graph.NewBlock(BlockBasic, "placeholder")
+			w.graph.AddEdge(current, placeholder, EdgePlaceholder)
+			after := w.graph.NewBlock(BlockBasic, "after_placeholder")
+			w.graph.AddEdge(w.expand(placeholder, after), after, EdgeFallthrough)
+			return after
+		}
+		current.appendStatement(node)
+		return current
+
+	case *ast.FunctionCall:
+		current.appendStatement(node)
+
+		switch builtinName(node) {
+		case "revert":
+			w.graph.AddEdge(current, w.graph.GetRevert(), EdgeRevert)
+			return nil
+		case "require", "assert":
+			next := w.graph.NewBlock(BlockBasic, "")
+			w.graph.AddEdge(current, next, EdgeTrue)
+			w.graph.AddEdge(current, w.graph.GetRevert(), EdgeRevert)
+			return next
+		}
+		return current
+
+	case *ast.Yul:
+		assembly := w.graph.NewBlock(BlockAssembly, "assembly")
+		w.graph.AddEdge(current, assembly, EdgeFallthrough)
+		w.yulSeen = make(map[int64]bool)
+		if node.GetBody() == nil {
+			return assembly
+		}
+		return w.walkYulStatements(node.GetBody().GetStatements(), assembly)
+
+	default:
+		current.appendStatement(statement)
+		return current
+	}
+}
+
+// walkOptional walks a statement that may be absent, such as an empty branch body.
+func (w *functionWalker) walkOptional(statement ast.Node[ast.NodeType], current *BasicBlock) *BasicBlock {
+	if statement == nil {
+		return current
+	}
+
+	// Typed nil bodies are common when the parser did not encounter a block.
+	if body, ok := statement.(*ast.BodyNode); ok && body == nil {
+		return current
+	}
+
+	return w.walkStatement(statement, current)
+}
+
+// walkLoopBody walks a loop body with break and continue targets pushed onto the loop stack.
+func (w *functionWalker) walkLoopBody(body ast.Node[ast.NodeType], current *BasicBlock, breakTo *BasicBlock, continueTo *BasicBlock) *BasicBlock {
+	w.loops = append(w.loops, loopTarget{breakTo: breakTo, continueTo: continueTo})
+	defer func() { w.loops = w.loops[:len(w.loops)-1] }()
+	return w.walkOptional(body, current)
+}
+
+// walkYulStatements walks a list of Yul statements starting in the current block.
+func (w *functionWalker) walkYulStatements(statements []ast.Node[ast.NodeType], current *BasicBlock) *BasicBlock {
+	for _, statement := range statements {
+		if statement == nil {
+			continue
+		}
+		current = w.walkYulStatement(statement, w.open(current))
+	}
+	return current
+}
+
+// walkYulStatement appends a single Yul statement to the graph and returns the block that
+// control flows into afterwards, or nil if the statement never falls through.
+func (w *functionWalker) walkYulStatement(statement ast.Node[ast.NodeType], current *BasicBlock) *BasicBlock {
+	switch node := statement.(type) {
+	case *ast.YulStatement:
+		if w.yulSeen[node.GetId()] {
+			return current
+		}
+		w.yulSeen[node.GetId()] = true
+		return w.walkYulStatements(node.Statements, current)
+
+	case *ast.YulBlockStatement:
+		return w.walkYulStatements(node.GetStatements(), current)
+
+	case *ast.YulIfStatement:
+		condition := w.graph.NewBlock(BlockCondition, "yul_if")
+		condition.appendStatement(node.GetCondition())
+		w.graph.AddEdge(current, condition, EdgeFallthrough)
+
+		then := w.graph.NewBlock(BlockBasic, "yul_then")
+		w.graph.AddEdge(condition, then, EdgeTrue)
+		thenEnd := w.walkYulOptional(node.GetBody(), then)
+
+		join := w.graph.NewBlock(BlockBasic, "yul_endif")
+		w.graph.AddEdge(condition, join, EdgeFalse)
+		w.graph.AddEdge(thenEnd, join, EdgeFallthrough)
+		return join
+
+	case *ast.YulForStatement:
+		current = w.walkYulOptional(node.GetPre(), current)
+		if current == nil {
+			return nil
+		}
+
+		head := w.graph.NewBlock(BlockLoop, "yul_for")
+		head.appendStatement(node.GetCondition())
+		w.graph.AddEdge(current, head, EdgeFallthrough)
+
+		post := w.graph.NewBlock(BlockBasic, "yul_for_post")
+		exit := w.graph.NewBlock(BlockBasic, "yul_endfor")
+		w.graph.AddEdge(head, exit, EdgeFalse)
+
+		body := w.graph.NewBlock(BlockBasic, "yul_for_body")
+		w.graph.AddEdge(head, body, EdgeTrue)
+
+		w.loops = append(w.loops, loopTarget{breakTo: exit, continueTo: post})
+		bodyEnd := w.walkYulOptional(node.GetBody(), body)
+		w.loops = w.loops[:len(w.loops)-1]
+
+		w.graph.AddEdge(bodyEnd, post, EdgeFallthrough)
+		w.graph.AddEdge(w.walkYulOptional(node.GetPost(), post), head, EdgeLoopBack)
+		return exit
+
+	case *ast.YulSwitchStatement:
+		current.appendStatement(node)
+		join := w.graph.NewBlock(BlockBasic, "yul_endswitch")
+
+		hasDefault := false
+		for _, c := range node.GetCases() {
+			switchCase, ok := c.(*ast.YulSwitchCaseStatement)
+			if !ok {
+				continue
+			}
+
+			block := w.graph.NewBlock(BlockBasic, "yul_case")
+			if switchCase.GetCase() == nil {
+				block.Label = "yul_default"
+				hasDefault = true
+			} else {
+				block.appendStatement(switchCase.GetCase())
+			}
+
+			w.graph.AddEdge(current, block, EdgeSwitchCase)
+			w.graph.AddEdge(w.walkYulOptional(switchCase.GetBody(), block), join, EdgeFallthrough)
+		}
+
+		if !hasDefault {
+			w.graph.AddEdge(current, join, EdgeFalse)
+		}
+		return join
+
+	case *ast.YulBreakStatement:
+		current.appendStatement(node)
+		if len(w.loops) > 0 {
+			w.graph.AddEdge(current, w.loops[len(w.loops)-1].breakTo, EdgeBreak)
+		}
+		return nil
+
+	case *ast.YulContinueStatement:
+		current.appendStatement(node)
+		if len(w.loops) > 0 {
+			w.graph.AddEdge(current, w.loops[len(w.loops)-1].continueTo, EdgeContinue)
+		}
+		return nil
+
+	case *ast.YulLeaveStatement:
+		current.appendStatement(node)
+		if len(w.yulFunctions) > 0 {
+			w.graph.AddEdge(current, w.yulFunctions[len(w.yulFunctions)-1], EdgeReturn)
+		}
+		return nil
+
+	case *ast.YulFunctionDefinition:
+		// The definition does not alter the control flow, its body executes when the function is called.
+		current.appendStatement(node)
+
+		function := w.graph.NewBlock(BlockAssembly, fmt.Sprintf("yul_function %s", node.GetName()))
+		w.graph.AddEdge(current, function, EdgeYulFunction)
+		end := w.graph.NewBlock(BlockBasic, "yul_endfunction")
+
+		// Loops enclosing the definition are not visible to its body.
+		loops := w.loops
+		w.loops = make([]loopTarget, 0)
+		w.yulFunctions = append(w.yulFunctions, end)
+		w.graph.AddEdge(w.walkYulOptional(node.GetBody(), function), end, EdgeFallthrough)
+		w.yulFunctions = w.yulFunctions[:len(w.yulFunctions)-1]
+		w.loops = loops
+
+		return current
+
+	case *ast.YulFunctionCallStatement:
+		current.appendStatement(node)
+		if node.FunctionName == nil {
+			return current
+		}
+
+		// Terminating builtins end the whole call, not just the enclosing Solidity function.
+		switch node.FunctionName.Name {
+		case "revert", "invalid":
+			w.graph.AddEdge(current, w.graph.GetRevert(), EdgeRevert)
+			return nil
+		case "return", "stop", "selfdestruct":
+			w.graph.AddEdge(current, w.graph.GetExit(), EdgeReturn)
+			return nil
+		}
+		return current
+
+	default:
+		current.appendStatement(statement)
+		return current
+	}
+}
+
+// walkYulOptional walks a Yul statement that may be absent.
+func (w *functionWalker) walkYulOptional(statement ast.Node[ast.NodeType], current *BasicBlock) *BasicBlock {
+	if statement == nil || current == nil {
+		return current
+	}
+	return w.walkYulStatement(statement, current)
+}
+
+// builtinName returns the name of the called identifier for direct calls such as require(...),
+// assert(...) or revert(...), and an empty string for any other call.
+func builtinName(call *ast.FunctionCall) string {
+	if primary, ok := call.GetExpression().(*ast.PrimaryExpression); ok {
+		return primary.GetName()
+	}
+	return ""
+}
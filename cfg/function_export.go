@@ -0,0 +1,54 @@
+package cfg
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goccy/go-json"
+)
+
+// ToJSON converts the function control flow graph, including its blocks and edges, to JSON.
+func (g *FunctionGraph) ToJSON() ([]byte, error) {
+	return json.Marshal(g)
+}
+
+// ToMermaid generates a string representation of the function control flow graph in Mermaid syntax.
+//
+// Every basic block is rendered as a node labelled with its kind, label and the node types of the
+// statements it holds. Edges carry their kind as the label, except plain fall-through edges.
+// Blocks that are unreachable from the entry block are rendered with dashed borders.
+func (g *FunctionGraph) ToMermaid() string {
+	var mermaidGraph strings.Builder
+	mermaidGraph.WriteString("graph TD\n")
+
+	for _, block := range g.Blocks {
+		if !block.Reachable && block.IsEmpty() && block.Kind != BlockExit && block.Kind != BlockRevert {
+			continue
+		}
+
+		label := string(block.Kind)
+		if block.Label != "" && block.Label != label {
+			label = fmt.Sprintf("%s: %s", label, block.Label)
+		}
+
+		lines := []string{label}
+		for _, statement := range block.Statements {
+			lines = append(lines, statement.NodeType.String())
+		}
+
+		mermaidGraph.WriteString(fmt.Sprintf("    B%d[\"%s\"]\n", block.Id, strings.Join(lines, "<br/>")))
+		if !block.Reachable && block.Kind != BlockExit && block.Kind != BlockRevert {
+			mermaidGraph.WriteString(fmt.Sprintf("    style B%d stroke-dasharray: 5 5\n", block.Id))
+		}
+	}
+
+	for _, edge := range g.Edges {
+		if edge.Kind == EdgeFallthrough {
+			mermaidGraph.WriteString(fmt.Sprintf("    B%d --> B%d\n", edge.From, edge.To))
+			continue
+		}
+		mermaidGraph.WriteString(fmt.Sprintf("    B%d -->|%s| B%d\n", edge.From, edge.Kind, edge.To))
+	}
+
+	return mermaidGraph.String()
+}
@@ -0,0 +1,248 @@
+package cfg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unpackdev/solgo"
+	"github.com/unpackdev/solgo/ir"
+	"github.com/unpackdev/solgo/tests"
+	"github.com/unpackdev/solgo/utils"
+)
+
+func TestFunctionGraphBuilder(t *testing.T) {
+	graphs := buildFunctionGraphsForTest(t, "FlowControl")
+
+	byName := make(map[string]*FunctionGraph)
+	for _, graph := range graphs {
+		byName[graph.GetName()] = graph
+	}
+
+	testCases := []struct {
+		name        string
+		blockKinds  []BlockKind
+		edgeKinds   []EdgeKind
+		labels      []string
+		edges       []labelledEdge
+		exitReached bool
+	}{
+		{
+			name:        "constructor",
+			exitReached: true,
+		},
+		{
+			name:       "sum",
+			blockKinds: []BlockKind{BlockModifier, BlockLoop, BlockCondition},
+			edgeKinds:  []EdgeKind{EdgePlaceholder, EdgeBreak, EdgeLoopBack, EdgeReturn, EdgeRevert},
+			edges: []labelledEdge{
+				{from: "onlyOwner", to: "", kind: EdgeTrue},
+				{from: "onlyOwner", to: "revert", kind: EdgeRevert},
+				{from: "", to: "placeholder", kind: EdgePlaceholder},
+				{from: "placeholder", to: "body", kind: EdgeFallthrough},
+				{from: "then", to: "endfor", kind: EdgeBreak},
+				{from: "for_closure", to: "for", kind: EdgeLoopBack},
+				{from: "endfor", to: "after_placeholder", kind: EdgeReturn},
+				{from: "after_placeholder", to: "exit", kind: EdgeFallthrough},
+			},
+			exitReached: true,
+		},
+		{
+			name:       "drain",
+			blockKinds: []BlockKind{BlockLoop},
+			edgeKinds:  []EdgeKind{EdgeLoopBack, EdgeRevert},
+			edges: []labelledEdge{
+				{from: "while", to: "while_body", kind: EdgeTrue},
+				{from: "while_body", to: "while", kind: EdgeLoopBack},
+				{from: "while", to: "endwhile", kind: EdgeFalse},
+				{from: "endwhile", to: "revert", kind: EdgeRevert},
+			},
+			exitReached: false,
+		},
+		{
+			name:        "load",
+			blockKinds:  []BlockKind{BlockAssembly, BlockCondition},
+			edgeKinds:   []EdgeKind{EdgeTrue, EdgeFalse, EdgeRevert},
+			exitReached: true,
+		},
+		{
+			name:       "classify",
+			blockKinds: []BlockKind{BlockCondition},
+			edgeKinds:  []EdgeKind{EdgeTrue, EdgeFalse},
+			labels:     []string{"then", "else", "endif"},
+			edges: []labelledEdge{
+				{from: "if", to: "then", kind: EdgeTrue},
+				{from: "if", to: "else", kind: EdgeFalse},
+				{from: "then", to: "endif", kind: EdgeFallthrough},
+				{from: "else", to: "endif", kind: EdgeFallthrough},
+				{from: "endif", to: "endif", kind: EdgeFallthrough},
+			},
+			exitReached: true,
+		},
+		{
+			name:       "countOdd",
+			blockKinds: []BlockKind{BlockLoop, BlockCondition},
+			edgeKinds:  []EdgeKind{EdgeContinue, EdgeLoopBack, EdgeFalse},
+			labels:     []string{"do_body", "do_while", "enddo"},
+			edges: []labelledEdge{
+				{from: "then", to: "do_while", kind: EdgeContinue},
+				{from: "do_while", to: "do_body", kind: EdgeLoopBack},
+				{from: "do_while", to: "enddo", kind: EdgeFalse},
+			},
+			exitReached: true,
+		},
+		{
+			name:        "tryTotal",
+			blockKinds:  []BlockKind{BlockTry, BlockCatch},
+			edgeKinds:   []EdgeKind{EdgeTrySuccess, EdgeCatch},
+			labels:      []string{"try_body", "catch Error", "endtry"},
+			exitReached: true,
+		},
+		{
+			name:        "firstNonZero",
+			blockKinds:  []BlockKind{BlockAssembly, BlockCondition},
+			edgeKinds:   []EdgeKind{EdgeYulFunction, EdgeReturn},
+			labels:      []string{"yul_function pick", "yul_endfunction"},
+			exitReached: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			graph, ok := byName[testCase.name]
+			require.True(t, ok)
+			assert.Equal(t, "FlowControl", graph.GetContract())
+			assert.True(t, graph.GetEntry().IsReachable())
+			assert.Equal(t, testCase.exitReached, graph.GetExit().IsReachable())
+			assert.Empty(t, graph.GetUnreachableBlocks())
+
+			blockKinds := make(map[BlockKind]bool)
+			labels := make(map[string]bool)
+			for _, block := range graph.GetBlocks() {
+				blockKinds[block.GetKind()] = true
+				labels[block.Label] = true
+			}
+			for _, kind := range testCase.blockKinds {
+				assert.True(t, blockKinds[kind], "missing block kind %s", kind)
+			}
+
+			for _, label := range testCase.labels {
+				assert.True(t, labels[label], "missing block %s", label)
+			}
+
+			edgeKinds := make(map[EdgeKind]bool)
+			for _, edge := range graph.GetEdges() {
+				edgeKinds[edge.Kind] = true
+			}
+			for _, kind := range testCase.edgeKinds {
+				assert.True(t, edgeKinds[kind], "missing edge kind %s", kind)
+			}
+
+			for _, expected := range testCase.edges {
+				assert.True(t, hasLabelledEdge(graph, expected), "missing %s edge from %q to %q", expected.kind, expected.from, expected.to)
+			}
+
+			assert.Contains(t, graph.ToMermaid(), "graph TD")
+
+			jsonData, err := graph.ToJSON()
+			assert.NoError(t, err)
+			assert.NotEmpty(t, jsonData)
+		})
+	}
+}
+
+func TestFunctionGraphJoins(t *testing.T) {
+	graphs := buildFunctionGraphsForTest(t, "FlowControl")
+
+	for _, graph := range graphs {
+		if graph.GetName() != "classify" {
+			continue
+		}
+
+		// Both branches of the nested if and else statements join in their endif blocks.
+		endifs := 0
+		for _, block := range graph.GetBlocks() {
+			if block.Label != "endif" {
+				continue
+			}
+
+			predecessors := 0
+			for _, edge := range graph.GetPredecessors(block.GetId()) {
+				if edge.Kind == EdgeFallthrough {
+					predecessors++
+				}
+			}
+			assert.Equal(t, 2, predecessors, "endif block %d", block.GetId())
+			endifs++
+		}
+		assert.Equal(t, 2, endifs)
+	}
+}
+
+func TestFunctionGraphModifierLinearization(t *testing.T) {
+	graphs := buildFunctionGraphsForTest(t, "Linearization")
+	require.Len(t, graphs, 1)
+
+	// Linearization is Right, Left, so Right overrides the modifier of Base ahead of Left.
+	graph := graphs[0]
+	modifiers := 0
+	for _, block := range graph.GetBlocks() {
+		if block.GetKind() != BlockModifier {
+			continue
+		}
+		assert.Equal(t, "guarded", block.Label)
+		modifiers++
+
+		statements := block.GetStatements()
+		require.NotEmpty(t, statements)
+		assert.Equal(t, int64(17), statements[0].Src.Line)
+	}
+	assert.Equal(t, 1, modifiers)
+}
+
+// labelledEdge is an expected edge between the blocks with the given labels.
+type labelledEdge struct {
+	from string
+	to   string
+	kind EdgeKind
+}
+
+// hasLabelledEdge reports whether the graph has the edge of the kind between the blocks with the labels.
+func hasLabelledEdge(graph *FunctionGraph, expected labelledEdge) bool {
+	for _, edge := range graph.GetEdges() {
+		if edge.Kind == expected.kind && graph.GetBlock(edge.From).Label == expected.from && graph.GetBlock(edge.To).Label == expected.to {
+			return true
+		}
+	}
+	return false
+}
+
+// buildFunctionGraphsForTest builds the function graphs of the contract from the cfg test data.
+func buildFunctionGraphsForTest(t *testing.T, name string) []*FunctionGraph {
+	sources := &solgo.Sources{
+		SourceUnits: []*solgo.SourceUnit{
+			{
+				Name:    name,
+				Path:    name + ".sol",
+				Content: tests.ReadContractFileForTest(t, "cfg/"+name).Content,
+			},
+		},
+		EntrySourceUnitName:  name,
+		MaskLocalSourcesPath: true,
+		LocalSourcesPath:     utils.GetLocalSourcesPath(),
+	}
+
+	parser, err := ir.NewBuilderFromSources(context.TODO(), sources)
+	require.NoError(t, err)
+	require.Empty(t, parser.Parse())
+	require.NoError(t, parser.Build())
+
+	builder, err := NewBuilder(context.Background(), parser)
+	require.NoError(t, err)
+	require.NoError(t, builder.Build())
+
+	graphs, err := builder.BuildFunctionGraphs(name)
+	require.NoError(t, err)
+	return graphs
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/unpackdev/solgo/bytecode"
 	"github.com/unpackdev/solgo/detector"
 	"github.com/unpackdev/solgo/ir"
+	"github.com/unpackdev/solgo/opcode"
 	"github.com/unpackdev/solgo/providers/etherscan"
 	"github.com/unpackdev/solgo/tokens"
 	"github.com/unpackdev/solgo/utils"
@@ -60,6 +61,9 @@ type Descriptor struct {
 	Proxy           bool             `json:"proxy"`
 	Implementations []common.Address `json:"implementations"`
 
+	// Bytecode pattern related fields.
+	BytecodePattern *opcode.PatternMatch `json:"bytecode_pattern,omitempty"`
+
 	// Token related fields.
 	Token *tokens.Descriptor `json:"token,omitempty"`
 }
@@ -254,6 +258,21 @@ func (d *Descriptor) IsSafe() bool {
 	return d.Safe
 }
 
+// GetBytecodePattern returns the well-known bytecode family the deployed bytecode belongs to, if discovered.
+func (d *Descriptor) GetBytecodePattern() *opcode.PatternMatch {
+	return d.BytecodePattern
+}
+
+// HasImplementation checks if the given address is among the discovered proxy implementations.
+func (d *Descriptor) HasImplementation(addr common.Address) bool {
+	for _, implementation := range d.Implementations {
+		if implementation == addr {
+			return true
+		}
+	}
+	return false
+}
+
 // HasAuditReport checks if an audit report is available for the contract, providing insights into security assessments.
 func (d *Descriptor) HasAuditReport() bool {
 	return d.Audit != nil
@@ -0,0 +1,59 @@
+package contracts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/unpackdev/solgo/opcode"
+)
+
+// EVMVersionCheck is the result of cross-checking the EVM version declared for the contract against the
+// instruction set required by its deployed bytecode.
+type EVMVersionCheck struct {
+	Declared   string                  `json:"declared"`   // EVM version as declared by the verification provider.
+	Target     opcode.Fork             `json:"target"`     // Fork the bytecode was validated against.
+	Minimum    opcode.Fork             `json:"minimum"`    // Earliest fork able to execute the bytecode.
+	Compatible bool                    `json:"compatible"` // Whether every opcode of the bytecode is available in the target fork.
+	Violations []*opcode.ForkViolation `json:"violations"` // Instructions unavailable or with changed semantics in the target fork.
+}
+
+// CheckEVMVersion validates the deployed bytecode of the contract against the EVM version declared in the
+// contract descriptor and infers the minimum EVM version the bytecode requires. A missing or "default"
+// declaration, as reported by etherscan for the compiler default, is checked against the latest fork.
+func (c *Contract) CheckEVMVersion() (*EVMVersionCheck, error) {
+	if len(c.descriptor.DeployedBytecode) < 1 {
+		if err := c.DiscoverDeployedBytecode(); err != nil {
+			return nil, err
+		}
+	}
+
+	toReturn := &EVMVersionCheck{
+		Declared: c.descriptor.GetEVMVersion(),
+		Target:   opcode.LatestFork,
+	}
+
+	if declared := strings.TrimSpace(toReturn.Declared); declared != "" && !strings.EqualFold(declared, "default") {
+		fork, err := opcode.ParseFork(declared)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse declared evm version: %w", err)
+		}
+		toReturn.Target = fork
+	}
+
+	decompiler, err := opcode.NewDecompiler(c.ctx, c.descriptor.DeployedBytecode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create decompiler: %w", err)
+	}
+	decompiler.SetFork(toReturn.Target)
+
+	if toReturn.Minimum, err = decompiler.GetMinimumFork(); err != nil {
+		return nil, fmt.Errorf("failed to infer minimum evm version: %w", err)
+	}
+
+	if toReturn.Violations, err = decompiler.ValidateFork(); err != nil {
+		return nil, fmt.Errorf("failed to validate bytecode against evm version %s: %w", toReturn.Target, err)
+	}
+
+	toReturn.Compatible = toReturn.Minimum <= toReturn.Target
+	return toReturn, nil
+}
@@ -0,0 +1,58 @@
+package contracts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/unpackdev/solgo/opcode"
+	"github.com/unpackdev/solgo/utils"
+)
+
+// DiscoverBytecodePattern classifies the deployed bytecode of the contract into well-known families such as
+// minimal proxies, metaproxies, Gnosis Safe proxies and CREATE2 factories. The match is stored in the contract
+// descriptor. When the contract is a proxy, it is marked as such and its implementation, either embedded in the
+// bytecode or read from the storage slot used by the pattern, is appended to the descriptor implementations.
+func (c *Contract) DiscoverBytecodePattern(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return nil
+	default:
+		if len(c.descriptor.DeployedBytecode) < 1 {
+			if err := c.DiscoverDeployedBytecode(); err != nil {
+				return err
+			}
+		}
+
+		decompiler, err := opcode.NewDecompiler(ctx, c.descriptor.DeployedBytecode)
+		if err != nil {
+			return fmt.Errorf("failed to create decompiler: %w", err)
+		}
+
+		match, err := decompiler.MatchBytecodePattern()
+		if err != nil {
+			return fmt.Errorf("failed to match bytecode pattern: %w", err)
+		}
+		c.descriptor.BytecodePattern = match
+
+		if !match.IsProxy() {
+			return nil
+		}
+
+		implementation := match.GetImplementation()
+		if slot := match.GetImplementationSlot(); slot != nil {
+			value, err := c.client.StorageAt(ctx, c.addr, *slot, nil)
+			if err != nil {
+				return fmt.Errorf("failed to get implementation from storage slot %s: %w", slot.Hex(), err)
+			}
+			implementation = common.BytesToAddress(value)
+		}
+
+		c.descriptor.Proxy = true
+		if implementation != utils.ZeroAddress && !c.descriptor.HasImplementation(implementation) {
+			c.descriptor.Implementations = append(c.descriptor.Implementations, implementation)
+		}
+
+		return nil
+	}
+}
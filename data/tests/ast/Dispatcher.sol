@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+contract Dispatcher {
+    function forward(bytes calldata data, address target) external payable returns (uint256 result) {
+        if (data.length > 4) {
+            result = 1;
+        } else {
+            result = 2;
+        }
+        bytes calldata args = data[4:];
+        Dispatcher(target).forward{value: msg.value, gas: 5000}({data: args, target: target});
+        assembly {
+            switch result
+            case 1 { result := 3 }
+            default { result := 4 }
+        }
+    }
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.19;
+
+contract Ownable {
+    address public owner;
+
+    modifier onlyOwner() {
+        require(msg.sender == owner, "not owner");
+        _;
+    }
+}
+
+contract FlowControl is Ownable {
+    uint256 public total;
+    uint256[] public values;
+
+    constructor() {
+        owner = msg.sender;
+    }
+
+    function sum(uint256 limit) public onlyOwner returns (uint256) {
+        uint256 acc = 0;
+        for (uint256 i = 0; i < values.length; i++) {
+            if (values[i] > limit) {
+                break;
+            }
+            acc += values[i];
+        }
+        total = acc;
+        return acc;
+    }
+
+    function drain(uint256 count) public {
+        while (count > 0) {
+            count--;
+        }
+        revert("drained");
+    }
+
+    function load(uint256 slot) public view returns (uint256 result) {
+        assembly {
+            if iszero(slot) {
+                revert(0, 0)
+            }
+            result := sload(slot)
+        }
+    }
+
+    function classify(uint256 value) public pure returns (uint256 class) {
+        if (value > 10) {
+            class = 2;
+        } else if (value > 5) {
+            class = 1;
+        } else {
+            class = 0;
+        }
+    }
+
+    function countOdd(uint256 limit) public pure returns (uint256 odd) {
+        uint256 i = 0;
+        do {
+            i++;
+            if (i % 2 == 0) {
+                continue;
+            }
+            odd++;
+        } while (i < limit);
+    }
+
+    function tryTotal(address target) public view returns (uint256 result) {
+        try FlowControl(target).total() returns (uint256 value) {
+            result = value;
+        } catch Error(string memory) {
+            result = 1;
+        } catch {
+            result = 0;
+        }
+    }
+
+    function firstNonZero(uint256 a, uint256 b) public pure returns (uint256 result) {
+        assembly {
+            function pick(x, y) -> z {
+                z := x
+                if iszero(x) {
+                    z := y
+                    leave
+                }
+            }
+            result := pick(a, b)
+        }
+    }
+}
@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.19;
+
+contract Base {
+    uint256 public value;
+
+    modifier guarded() virtual {
+        value = 1;
+        _;
+    }
+}
+
+contract Left is Base {}
+
+contract Right is Base {
+    modifier guarded() virtual override {
+        value = 2;
+        _;
+    }
+}
+
+contract Linearization is Right, Left {
+    function run() public guarded {
+        value += 1;
+    }
+}